@@ -2,16 +2,38 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
 	"fmt"
 	"log"
+	"os"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"multiplayer-webservice/internal/proto" // Update to the correct import path
 )
 
 func main() {
+	addr := flag.String("addr", ":50051", "gRPC server address")
+	caFile := flag.String("tls-ca", "", "PEM CA bundle used to verify the server; enables TLS")
+	certFile := flag.String("tls-cert", "", "PEM client certificate for mutual TLS")
+	keyFile := flag.String("tls-key", "", "PEM private key for -tls-cert")
+	serverName := flag.String("tls-server-name", "localhost", "server name to verify")
+	flag.Parse()
+
+	creds := insecure.NewCredentials()
+	if *caFile != "" {
+		tlsConfig, err := clientTLSConfig(*caFile, *certFile, *keyFile, *serverName)
+		if err != nil {
+			log.Fatalf("invalid TLS configuration: %v", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
 	// Set up a connection to the server
-	conn, err := grpc.Dial(":50051", grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
 	}
@@ -36,3 +58,25 @@ func main() {
 		fmt.Printf("Mode: %s, Active Users: %d\n", mode.ModeName, mode.ActiveUsers)
 	}
 }
+
+// clientTLSConfig trusts the given CA and, when a certificate is supplied, presents it for mutual TLS.
+func clientTLSConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+
+	tlsConfig := &tls.Config{RootCAs: rootCAs, ServerName: serverName, MinVersion: tls.VersionTLS12}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
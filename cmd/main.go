@@ -2,57 +2,332 @@ package main
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"os"
 	"net"
+	"net/http"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
 	"multiplayer-webservice/internal/cache"
 	"multiplayer-webservice/internal/config"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"multiplayer-webservice/internal/auth"
 	"multiplayer-webservice/internal/handlers"
+	healthcheck "multiplayer-webservice/internal/health"
+	"multiplayer-webservice/internal/idempotency"
+	"multiplayer-webservice/internal/logging"
+	"multiplayer-webservice/internal/logic"
 	"multiplayer-webservice/internal/proto"
+	"multiplayer-webservice/internal/ratelimit"
+	"multiplayer-webservice/internal/tlsconfig"
+	"multiplayer-webservice/internal/workers"
 )
 
 var collection *mongo.Collection
 
+// dialCredentials are used by the HTTP API when it calls the local gRPC server.
+var dialCredentials = insecure.NewCredentials()
+
 func main() {
-	err := config.LoadConfig()
+	err := config.LoadConfig(os.Args[1:])
 	if err != nil {
-		log.Fatalf("failed to load config: %v", err)
+		fatal("failed to load config", err)
+	}
+
+	if err := logging.Setup(config.AppConfig.LogLevel); err != nil {
+		fatal("failed to configure logging", err)
 	}
+	slog.Info("Loaded configuration", "config", config.AppConfig)
 
 	err = connectToMongoDB()
 	if err != nil {
-		log.Fatalf("Error connecting to MongoDB: %v", err)
+		fatal("Error connecting to MongoDB", err)
 	}
 
-	redisCache, err := cache.InitializeCache(config.AppConfig.RedisAddr, config.AppConfig.RedisPass, config.AppConfig.RedisDB)
+	redisCache, err := cache.InitializeCacheWithOptions(&redis.Options{
+		Addr:     config.AppConfig.RedisAddr,
+		Password: config.AppConfig.RedisPass,
+		DB:       config.AppConfig.RedisDB,
+		PoolSize: config.AppConfig.RedisPoolSize,
+	})
 	if err != nil {
-		log.Fatalf("failed to initialize Redis cache: %v", err)
+		fatal("failed to initialize Redis cache", err)
+	}
+	slog.Info("Redis cache initialized successfully")
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Background workers run until workerCtx is cancelled and flush any pending work before returning.
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workerGroup sync.WaitGroup
+	runWorker := func(fn func(context.Context)) {
+		workerGroup.Add(1)
+		go func() {
+			defer workerGroup.Done()
+			fn(workerCtx)
+		}()
+	}
+
+	limiter := ratelimit.NewLimiter(0, 0)
+	config.Subscribe(func(settings config.RuntimeSettings) {
+		if err := logging.SetLevel(settings.LogLevel); err != nil {
+			slog.Error("Ignoring invalid log level", "error", err)
+		}
+		limiter.SetLimit(settings.RateLimitRPS, settings.RateLimitBurst)
+	})
+	runWorker(func(ctx context.Context) {
+		config.WatchForChanges(ctx, os.Args[1:])
+	})
+
+	var tlsReloader *tlsconfig.Reloader
+	if config.AppConfig.TLSEnabled() {
+		tlsReloader, err = newTLSReloader()
+		if err != nil {
+			fatal("failed to load TLS certificates", err)
+		}
+		runWorker(func(ctx context.Context) {
+			tlsReloader.Watch(ctx, config.AppConfig.ConfigReloadInterval)
+		})
+	}
+	authorizer := auth.NewAuthorizer(config.AppConfig.AdminIdentities)
+
+	checker := healthcheck.NewChecker(collection.Database().Client(), redisCache)
+	healthServer := health.NewServer()
+	runWorker(func(ctx context.Context) {
+		checker.Watch(ctx, healthServer, config.AppConfig.HealthCheckInterval, proto.MultiplayerService_ServiceDesc.ServiceName)
+	})
+
+	if err := logic.EnsureIndexes(context.Background(), collection); err != nil {
+		slog.Error("Failed to create indexes", "error", err)
+	}
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "active-users-sampler", config.AppConfig.HistorySampleInterval, func(ctx context.Context) error {
+			return logic.SampleActiveUsersLogic(ctx, collection, time.Now())
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "active-users-downsampler", time.Hour, func(ctx context.Context) error {
+			return logic.DownsampleActiveUsersLogic(ctx, collection, time.Now(), config.AppConfig.HistoryRawRetention, config.AppConfig.HistoryRetention)
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "mode-scheduler", config.AppConfig.ModeScheduleInterval, func(ctx context.Context) error {
+			return logic.ApplyModeSchedulesLogic(ctx, collection, redisCache, time.Now())
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "season-archiver", time.Minute, func(ctx context.Context) error {
+			return logic.ArchiveEndedSeasonsLogic(ctx, collection, time.Now())
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "game-server-evictor", config.AppConfig.GameServerHeartbeatTTL, func(ctx context.Context) error {
+			evicted, err := logic.EvictStaleServersLogic(ctx, collection, config.AppConfig.GameServerHeartbeatTTL, time.Now())
+			if evicted > 0 {
+				slog.Info("Evicted game servers without heartbeats", "count", evicted)
+			}
+			return err
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "session-evictor", config.AppConfig.SessionEvictionInterval, func(ctx context.Context) error {
+			evicted, err := logic.EvictStaleSessionsLogic(ctx, collection, time.Now())
+			if evicted > 0 {
+				slog.Info("Closed sessions of players no longer in their mode", "count", evicted)
+			}
+			return err
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "reservation-sweeper", time.Minute, func(ctx context.Context) error {
+			return logic.ReleaseExpiredReservationsLogic(ctx, collection, time.Now())
+		})
+	})
+	// Webhook subscriptions are fed from the outbox like any other sink
+	outboxSinks := []logic.OutboxSink{logic.NewWebhookDispatcher(collection)}
+	for _, spec := range config.AppConfig.OutboxSinks {
+		sink, err := logic.ParseOutboxSink(spec, redisCache)
+		if err != nil {
+			fatal("failed to set up outbox sink", err)
+		}
+		outboxSinks = append(outboxSinks, sink)
 	}
-	log.Println("Redis cache initialized successfully")
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "outbox-relay", config.AppConfig.OutboxRelayInterval, func(ctx context.Context) error {
+			// A failing sink must not hold back the others; each resumes from its own offset
+			var errs []error
+			for _, sink := range outboxSinks {
+				if _, err := logic.RelayOutboxLogic(ctx, collection, sink, int64(config.AppConfig.OutboxBatchSize)); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "outbox-pruner", time.Hour, func(ctx context.Context) error {
+			return errors.Join(
+				logic.PruneOutboxLogic(ctx, collection, config.AppConfig.OutboxRetention, time.Now()),
+				logic.PruneWebhookDeliveriesLogic(ctx, collection, config.AppConfig.OutboxRetention, time.Now()),
+			)
+		})
+	})
+	webhookClient := &http.Client{Timeout: config.AppConfig.WebhookTimeout}
+	webhookPolicy := logic.WebhookRetryPolicy{
+		MaxAttempts: config.AppConfig.WebhookMaxAttempts,
+		BaseDelay:   config.AppConfig.WebhookRetryBaseDelay,
+		MaxDelay:    config.AppConfig.WebhookRetryMaxDelay,
+	}
+	runWorker(func(ctx context.Context) {
+		workers.RunPeriodic(ctx, "webhook-deliverer", config.AppConfig.WebhookDeliveryInterval, func(ctx context.Context) error {
+			_, err := logic.DeliverWebhooksLogic(ctx, collection, webhookClient, webhookPolicy, int64(config.AppConfig.OutboxBatchSize), time.Now())
+			return err
+		})
+	})
+	runWorker(func(ctx context.Context) {
+		persist := func(ctx context.Context) error {
+			return logic.PersistLeaderboardsLogic(ctx, collection, redisCache)
+		}
+		workers.RunPeriodic(ctx, "leaderboard-persister", config.AppConfig.LeaderboardPersistInterval, persist)
+
+		// Flush scores submitted since the last run; shutdown waits for this before closing the clients
+		flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), config.AppConfig.ShutdownTimeout)
+		defer cancel()
+		if err := persist(flushCtx); err != nil {
+			slog.Error("Failed to persist leaderboards on shutdown", "error", err)
+		}
+	})
 
-	go startGRPCServer(redisCache)
+	serveErrs := make(chan error, 2)
 
-	router := gin.Default()
+	idempotencyStore := idempotency.NewStore(redisCache, config.AppConfig.IdempotencyWindow)
+	grpcServer, lis, err := newGRPCServer(redisCache, healthServer, limiter, tlsReloader, authorizer, idempotencyStore)
+	if err != nil {
+		fatal("failed to listen", err)
+	}
+	go func() {
+		slog.Info("Starting gRPC server", "port", config.AppConfig.GRPCPort, "tls", tlsReloader != nil)
+		if err := grpcServer.Serve(lis); err != nil {
+			serveErrs <- fmt.Errorf("gRPC server: %w", err)
+		}
+	}()
+
+	router := gin.New()
+	router.Use(gin.Recovery(), logging.GinMiddleware(), auth.GinMiddleware())
 	router.GET("/", func(c *gin.Context) {
 		c.JSON(200, gin.H{"message": "Multiplayer Web Service is running!"})
 	})
+	router.GET("/healthz", handlers.Healthz)
+	router.GET("/readyz", handlers.Readyz(checker))
 	router.GET("/total-active-users", getTotalActiveUsers)
+	router.GET("/admin/config", authorizer.RequireAdminHTTP(), handlers.AdminConfig)
 
-	port := config.AppConfig.ServerPort
-	fmt.Printf("Starting HTTP server on port %s\n", port)
-	log.Fatal(router.Run(":" + port))
+	httpServer := &http.Server{
+		Addr:    ":" + config.AppConfig.ServerPort,
+		Handler: router,
+	}
+	if tlsReloader != nil {
+		httpServer.TLSConfig = tlsReloader.ServerConfig()
+	}
+	go func() {
+		slog.Info("Starting HTTP server", "port", config.AppConfig.ServerPort, "tls", tlsReloader != nil)
+		var err error
+		if tlsReloader != nil {
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErrs <- fmt.Errorf("HTTP server: %w", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutdown signal received")
+	case err := <-serveErrs:
+		slog.Error("Server stopped unexpectedly", "error", err)
+	}
+
+	shutdown(healthServer, httpServer, grpcServer, stopWorkers, &workerGroup, redisCache)
+}
+
+// shutdown stops accepting traffic, drains in-flight requests, stops the background workers and
+// finally closes the Redis and MongoDB clients. Every step shares a single ShutdownTimeout deadline.
+func shutdown(healthServer *health.Server, httpServer *http.Server, grpcServer *grpc.Server, stopWorkers context.CancelFunc, workerGroup *sync.WaitGroup, redisCache *cache.RedisCache) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.AppConfig.ShutdownTimeout)
+	defer cancel()
+
+	// Report NOT_SERVING first so load balancers stop routing new calls to this instance.
+	healthServer.Shutdown()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		slog.Error("HTTP server shutdown", "error", err)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Warn("gRPC graceful stop timed out, forcing stop")
+		grpcServer.Stop()
+	}
+
+	stopWorkers()
+	drained := make(chan struct{})
+	go func() {
+		workerGroup.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		slog.Warn("Timed out waiting for background workers to finish")
+	}
+
+	if err := redisCache.Close(); err != nil {
+		slog.Error("Redis client close", "error", err)
+	}
+	if err := collection.Database().Client().Disconnect(ctx); err != nil {
+		slog.Error("MongoDB disconnect", "error", err)
+	}
+	slog.Info("Shutdown complete")
+}
+
+// fatal logs the error and exits; used for unrecoverable startup failures.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
 
 func connectToMongoDB() error {
 	uri := config.AppConfig.MongoDBURI
-	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	clientOptions := options.Client().
+		ApplyURI(uri).
+		SetMaxPoolSize(uint64(config.AppConfig.MongoMaxPoolSize)).
+		SetConnectTimeout(config.AppConfig.MongoConnectTimeout)
+	client, err := mongo.Connect(context.Background(), clientOptions)
 	if err != nil {
 		return err
 	}
@@ -60,44 +335,96 @@ func connectToMongoDB() error {
 	if err != nil {
 		return err
 	}
-	collection = client.Database("multiplayer").Collection("modes")
-	fmt.Println("Successfully connected to MongoDB!")
+	if err := logic.CheckTransactions(context.Background(), client); err != nil {
+		return err
+	}
+	collection = client.Database(config.AppConfig.MongoDatabase).Collection(config.AppConfig.MongoCollection)
+	slog.Info("Successfully connected to MongoDB")
 	return nil
 }
 
-func startGRPCServer(redisCache *cache.RedisCache) {
+// newGRPCServer builds the gRPC server with all services registered and binds its listener.
+func newGRPCServer(redisCache *cache.RedisCache, healthServer *health.Server, limiter *ratelimit.Limiter, tlsReloader *tlsconfig.Reloader, authorizer *auth.Authorizer, idempotencyStore *idempotency.Store) (*grpc.Server, net.Listener, error) {
 	lis, err := net.Listen("tcp", ":"+config.AppConfig.GRPCPort)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		return nil, nil, err
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(),
+			limiter.UnaryServerInterceptor(),
+			idempotencyStore.UnaryServerInterceptor(),
+			timeoutInterceptor(config.AppConfig.RequestTimeout),
+		),
+		grpc.ChainStreamInterceptor(logging.StreamServerInterceptor(), auth.StreamServerInterceptor()),
+	}
+	if tlsReloader != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsReloader.ServerConfig())))
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverOptions...)
 	multiplayerHandler := &handlers.MultiplayerService{
 		Collection: collection,
 		RedisCache: redisCache,
+		Authorizer: authorizer,
+		ChatHooks: logic.ChatHooks{
+			Filters: []logic.ChatFilter{logic.NewWordFilter(config.AppConfig.ChatBlockedWords)},
+			Mutes:   logic.NewSanctionMuteChecker(collection),
+		},
 	}
 	proto.RegisterMultiplayerServiceServer(grpcServer, multiplayerHandler)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
 
-	fmt.Printf("Starting gRPC server on port %s\n", config.AppConfig.GRPCPort)
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	return grpcServer, lis, nil
+}
+
+// newTLSReloader loads the listener certificates and switches the loopback gRPC client to TLS,
+// presenting the server certificate so that it also passes mutual TLS.
+func newTLSReloader() (*tlsconfig.Reloader, error) {
+	clientAuth, err := tlsconfig.ParseClientAuth(config.AppConfig.TLSClientAuth)
+	if err != nil {
+		return nil, err
+	}
+	reloader, err := tlsconfig.NewReloader(config.AppConfig.TLSCertFile, config.AppConfig.TLSKeyFile, config.AppConfig.TLSClientCAFile, clientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootCAs *x509.CertPool // nil means the system pool
+	if config.AppConfig.TLSClientCAFile != "" {
+		if rootCAs, err = tlsconfig.LoadCertPool(config.AppConfig.TLSClientCAFile); err != nil {
+			return nil, err
+		}
+	}
+	dialCredentials = credentials.NewTLS(reloader.ClientConfig(rootCAs, config.AppConfig.TLSServerName))
+	return reloader, nil
+}
+
+// timeoutInterceptor bounds every unary call with the configured request timeout.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
 	}
 }
 
 func getTotalActiveUsers(c *gin.Context) {
-    conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+    conn, err := grpc.Dial("localhost:"+config.AppConfig.GRPCPort, grpc.WithTransportCredentials(dialCredentials))
     if err != nil {
-        log.Printf("gRPC connection error: %v", err)
+        slog.ErrorContext(c.Request.Context(), "gRPC connection error", "error", err)
         c.JSON(500, gin.H{"message": "Failed to connect to gRPC server"})
         return
     }
     defer conn.Close()
 
     client := proto.NewMultiplayerServiceClient(conn)
-    resp, err := client.GetTotalActiveUsers(context.Background(), &proto.TotalActiveUsersRequest{})
+    resp, err := client.GetTotalActiveUsers(logging.OutgoingContext(c.Request.Context()), &proto.TotalActiveUsersRequest{})
     if err != nil {
-        log.Printf("gRPC request error: %v", err)
+        slog.ErrorContext(c.Request.Context(), "gRPC request error", "error", err)
         c.JSON(500, gin.H{"message": "Failed to fetch total active users"})
         return
     }
@@ -2,7 +2,7 @@ package cache
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -14,12 +14,16 @@ type RedisCache struct {
 
 // InitializeCache initializes a Redis client and tests the connection.
 func InitializeCache(addr string, password string, db int) (*RedisCache, error) {
-
-	client := redis.NewClient(&redis.Options{
+	return InitializeCacheWithOptions(&redis.Options{
 		Addr:     addr,
-		Password: "", // If no password, set it to an empty string ""
+		Password: password, // If no password, set it to an empty string ""
 		DB:       db,
 	})
+}
+
+// InitializeCacheWithOptions is InitializeCache for callers that also tune the connection pool.
+func InitializeCacheWithOptions(options *redis.Options) (*RedisCache, error) {
+	client := redis.NewClient(options)
 
 	// Test the connection
 	if err := client.Ping(context.Background()).Err(); err != nil {
@@ -33,7 +37,7 @@ func InitializeCache(addr string, password string, db int) (*RedisCache, error)
 func (r *RedisCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
 	err := r.Client.Set(ctx, key, value, ttl).Err()
 	if err != nil {
-		log.Printf("Failed to set cache for key: %s, error: %v", key, err)
+		slog.WarnContext(ctx, "Failed to set cache", "key", key, "error", err)
 	}
 	return err
 }
@@ -42,11 +46,11 @@ func (r *RedisCache) Set(ctx context.Context, key string, value string, ttl time
 func (r *RedisCache) Get(ctx context.Context, key string) (string, error) {
 	result, err := r.Client.Get(ctx, key).Result()
 	if err == redis.Nil {
-		log.Printf("Cache miss for key: %s", key)
+		slog.DebugContext(ctx, "Cache miss", "key", key)
 	} else if err != nil {
-		log.Printf("Error fetching key %s from cache: %v", key, err)
+		slog.WarnContext(ctx, "Error fetching key from cache", "key", key, "error", err)
 	} else {
-		log.Printf("Cache hit for key: %s", key)
+		slog.DebugContext(ctx, "Cache hit", "key", key)
 	}
 	return result, err
 }
@@ -55,7 +59,12 @@ func (r *RedisCache) Get(ctx context.Context, key string) (string, error) {
 func (r *RedisCache) Delete(ctx context.Context, key string) error {
 	err := r.Client.Del(ctx, key).Err()
 	if err != nil {
-		log.Printf("Failed to delete cache for key: %s, error: %v", key, err)
+		slog.WarnContext(ctx, "Failed to delete cache", "key", key, "error", err)
 	}
 	return err
-}
\ No newline at end of file
+}
+
+// Close releases the underlying Redis connection pool
+func (r *RedisCache) Close() error {
+	return r.Client.Close()
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// fallbackUniques holds members that could not be written to Redis. They are replayed into the
+// HyperLogLog the next time the key is successfully touched, so an outage does not lose players.
+var fallbackUniques = &memoryUniques{sets: make(map[string]map[string]struct{}), ttls: make(map[string]time.Duration)}
+
+type memoryUniques struct {
+	mu   sync.Mutex
+	sets map[string]map[string]struct{}
+	// ttls holds the TTL each key was last added with, applied when its members are replayed.
+	ttls map[string]time.Duration
+}
+
+func (m *memoryUniques) add(key string, ttl time.Duration, members ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	set, ok := m.sets[key]
+	if !ok {
+		set = make(map[string]struct{})
+		m.sets[key] = set
+	}
+	for _, member := range members {
+		set[member] = struct{}{}
+	}
+	m.ttls[key] = ttl
+}
+
+// take removes and returns the pending members of key with the TTL they were added with.
+func (m *memoryUniques) take(key string) ([]string, time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	set, ttl := m.sets[key], m.ttls[key]
+	delete(m.sets, key)
+	delete(m.ttls, key)
+	members := make([]string, 0, len(set))
+	for member := range set {
+		members = append(members, member)
+	}
+	return members, ttl
+}
+
+func (m *memoryUniques) count(key string) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return int64(len(m.sets[key]))
+}
+
+// AddUnique records members in the HyperLogLog at key and refreshes its TTL. When Redis is
+// unavailable the members are kept in memory instead and the error is only logged.
+func (r *RedisCache) AddUnique(ctx context.Context, key string, ttl time.Duration, members ...string) {
+	pending, _ := fallbackUniques.take(key)
+	all := append(append([]string{}, members...), pending...)
+	pipe := r.Client.TxPipeline()
+	pipe.PFAdd(ctx, key, toInterfaces(all)...)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.WarnContext(ctx, "Failed to add to HyperLogLog, using in-memory fallback", "key", key, "error", err)
+		fallbackUniques.add(key, ttl, all...)
+	}
+}
+
+// CountUnique returns the approximate number of distinct members added to key. While Redis is
+// unavailable it returns the exact count of the members held in memory.
+func (r *RedisCache) CountUnique(ctx context.Context, key string) int64 {
+	if pending, ttl := fallbackUniques.take(key); len(pending) > 0 {
+		pipe := r.Client.TxPipeline()
+		pipe.PFAdd(ctx, key, toInterfaces(pending)...)
+		pipe.Expire(ctx, key, ttl)
+		if _, err := pipe.Exec(ctx); err != nil {
+			fallbackUniques.add(key, ttl, pending...)
+		}
+	}
+
+	count, err := r.Client.PFCount(ctx, key).Result()
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to count HyperLogLog, using in-memory fallback", "key", key, "error", err)
+		return fallbackUniques.count(key)
+	}
+	return count
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
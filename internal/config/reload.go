@@ -0,0 +1,103 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"syscall"
+	"time"
+)
+
+// WatchForChanges reloads the configuration from the same sources (args, file, .env file, environment) on SIGHUP
+// and whenever the config file's modification time changes, until ctx is cancelled.
+// Only RuntimeSettings are applied; changes to any other field are logged and need a restart.
+// An invalid configuration is logged and the previous settings are kept.
+func WatchForChanges(ctx context.Context, args []string) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	interval := AppConfig.ConfigReloadInterval
+	if interval <= 0 {
+		interval = DefaultConfig().ConfigReloadInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastModified := modTime(AppConfig.ConfigFile)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			slog.Info("SIGHUP received, reloading configuration")
+			Reload(args)
+		case <-ticker.C:
+			if AppConfig.ConfigFile == "" {
+				continue
+			}
+			if modified := modTime(AppConfig.ConfigFile); !modified.Equal(lastModified) {
+				lastModified = modified
+				slog.Info("Config file changed, reloading configuration", "path", AppConfig.ConfigFile)
+				Reload(args)
+			}
+		}
+	}
+}
+
+// Reload re-reads the configuration and publishes the runtime subset to subscribers.
+func Reload(args []string) error {
+	cfg, err := Load(args)
+	if err != nil {
+		slog.Error("Configuration reload rejected, keeping previous settings", "error", err)
+		return err
+	}
+
+	if ignored := restartRequired(AppConfig, cfg); len(ignored) > 0 {
+		slog.Warn("Configuration changes require a restart to take effect", "fields", ignored)
+	}
+
+	publish(cfg.Runtime())
+	slog.Info("Runtime settings reloaded", "settings", cfg.Runtime())
+	return nil
+}
+
+// restartRequired lists the fields that differ between old and new but are not hot-reloadable.
+func restartRequired(old, new AppConfigStruct) []string {
+	before, after := old.Effective(), new.Effective()
+	var fields []string
+	for key, value := range after {
+		if !reloadableFields[key] && !reflect.DeepEqual(before[key], value) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// reloadableFields are the Effective keys backed by RuntimeSettings.
+var reloadableFields = map[string]bool{
+	"cache_ttl":                  true,
+	"log_level":                  true,
+	"rate_limit_rps":             true,
+	"rate_limit_burst":           true,
+	"default_mode_capacity":      true,
+	"mode_capacities":            true,
+	"default_spectator_capacity": true,
+	"spectator_capacities":       true,
+	"features":                   true,
+}
+
+func modTime(path string) time.Time {
+	if path == "" {
+		return time.Time{}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// RuntimeSettings is the subset of the configuration that can change while the service is running.
+// Components read the current values with Runtime or register for changes with Subscribe.
+type RuntimeSettings struct {
+	CacheTTL                 time.Duration   `json:"cache_ttl"`
+	LogLevel                 string          `json:"log_level"`
+	RateLimitRPS             float64         `json:"rate_limit_rps"`
+	RateLimitBurst           int             `json:"rate_limit_burst"`
+	DefaultModeCapacity      int             `json:"default_mode_capacity"`
+	ModeCapacities           map[string]int  `json:"mode_capacities"`
+	DefaultSpectatorCapacity int             `json:"default_spectator_capacity"`
+	SpectatorCapacities      map[string]int  `json:"spectator_capacities"`
+	Features                 map[string]bool `json:"features"`
+}
+
+// MarshalJSON renders durations as human-readable strings such as "10m0s".
+func (r RuntimeSettings) MarshalJSON() ([]byte, error) {
+	type plain RuntimeSettings
+	return json.Marshal(struct {
+		plain
+		CacheTTL string `json:"cache_ttl"`
+	}{plain: plain(r), CacheTTL: r.CacheTTL.String()})
+}
+
+// Runtime extracts the hot-reloadable settings from the configuration.
+func (c AppConfigStruct) Runtime() RuntimeSettings {
+	return RuntimeSettings{
+		CacheTTL:                 c.CacheTTL,
+		LogLevel:                 c.LogLevel,
+		RateLimitRPS:             c.RateLimitRPS,
+		RateLimitBurst:           c.RateLimitBurst,
+		DefaultModeCapacity:      c.DefaultModeCapacity,
+		ModeCapacities:           c.ModeCapacities,
+		DefaultSpectatorCapacity: c.DefaultSpectatorCapacity,
+		SpectatorCapacities:      c.SpectatorCapacities,
+		Features:                 c.Features,
+	}
+}
+
+// ModeCapacity returns the maximum number of players allowed in a mode, or 0 for unlimited.
+func (r RuntimeSettings) ModeCapacity(modeName string) int {
+	if capacity, ok := r.ModeCapacities[modeName]; ok {
+		return capacity
+	}
+	return r.DefaultModeCapacity
+}
+
+// SpectatorCapacity returns the maximum number of spectators allowed in a mode, or 0 for unlimited.
+func (r RuntimeSettings) SpectatorCapacity(modeName string) int {
+	if capacity, ok := r.SpectatorCapacities[modeName]; ok {
+		return capacity
+	}
+	return r.DefaultSpectatorCapacity
+}
+
+// FeatureEnabled reports whether the named feature toggle is switched on.
+func (r RuntimeSettings) FeatureEnabled(name string) bool {
+	return r.Features[name]
+}
+
+var (
+	runtimeMu   sync.RWMutex
+	current     = DefaultConfig().Runtime()
+	subscribers []func(RuntimeSettings)
+)
+
+// Runtime returns the current hot-reloadable settings. Before LoadConfig runs it returns the defaults.
+func Runtime() RuntimeSettings {
+	runtimeMu.RLock()
+	defer runtimeMu.RUnlock()
+	return current
+}
+
+// Subscribe registers fn to be called with the new settings after every successful reload.
+// fn is also called immediately with the current settings so subscribers need no separate initialisation.
+func Subscribe(fn func(RuntimeSettings)) {
+	runtimeMu.Lock()
+	subscribers = append(subscribers, fn)
+	settings := current
+	runtimeMu.Unlock()
+
+	fn(settings)
+}
+
+// publish stores the new settings and notifies every subscriber.
+func publish(settings RuntimeSettings) {
+	runtimeMu.Lock()
+	current = settings
+	notify := append([]func(RuntimeSettings){}, subscribers...)
+	runtimeMu.Unlock()
+
+	for _, fn := range notify {
+		fn(settings)
+	}
+}
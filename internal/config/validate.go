@@ -0,0 +1,211 @@
+package config
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"multiplayer-webservice/internal/logging"
+)
+
+// ValidationError describes one invalid configuration field.
+type ValidationError struct {
+	Field   string
+	Problem string
+}
+
+func (e ValidationError) Error() string {
+	return e.Field + ": " + e.Problem
+}
+
+// ValidationErrors collects every invalid field so that operators can fix them all in one go.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "invalid configuration: " + strings.Join(msgs, "; ")
+}
+
+// validate checks the fully merged configuration.
+func (c AppConfigStruct) validate() ValidationErrors {
+	var errs ValidationErrors
+	add := func(field, problem string) {
+		errs = append(errs, ValidationError{Field: field, Problem: problem})
+	}
+
+	if c.MongoDBURI == "" {
+		add("mongodb_uri", "is required")
+	} else if !strings.HasPrefix(c.MongoDBURI, "mongodb://") && !strings.HasPrefix(c.MongoDBURI, "mongodb+srv://") {
+		add("mongodb_uri", "must start with mongodb:// or mongodb+srv://")
+	}
+	if c.MongoDatabase == "" {
+		add("mongodb_database", "is required")
+	}
+	if c.MongoCollection == "" {
+		add("mongodb_collection", "is required")
+	}
+	if c.MongoMaxPoolSize <= 0 {
+		add("mongodb_max_pool_size", "must be greater than 0")
+	}
+	if c.RedisAddr == "" {
+		add("redis_addr", "is required")
+	} else if _, _, err := net.SplitHostPort(c.RedisAddr); err != nil {
+		add("redis_addr", "must be host:port")
+	}
+	if c.RedisDB < 0 {
+		add("redis_db", "must not be negative")
+	}
+	if c.RedisPoolSize <= 0 {
+		add("redis_pool_size", "must be greater than 0")
+	}
+	if !validPort(c.ServerPort) {
+		add("server_port", "must be a port number between 1 and 65535")
+	}
+	if !validPort(c.GRPCPort) {
+		add("grpc_port", "must be a port number between 1 and 65535")
+	}
+	if c.ServerPort != "" && c.ServerPort == c.GRPCPort {
+		add("grpc_port", "must differ from server_port")
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		add("log_level", err.Error())
+	}
+
+	if c.RateLimitRPS < 0 {
+		add("rate_limit_rps", "must not be negative")
+	}
+	if c.RateLimitRPS > 0 && c.RateLimitBurst <= 0 {
+		add("rate_limit_burst", "must be greater than 0 when rate_limit_rps is set")
+	}
+	if c.DefaultModeCapacity < 0 {
+		add("default_mode_capacity", "must not be negative")
+	}
+	for mode, capacity := range c.ModeCapacities {
+		if capacity < 0 {
+			add("mode_capacities."+mode, "must not be negative")
+		}
+	}
+	if c.DefaultSpectatorCapacity < 0 {
+		add("default_spectator_capacity", "must not be negative")
+	}
+	for mode, capacity := range c.SpectatorCapacities {
+		if capacity < 0 {
+			add("spectator_capacities."+mode, "must not be negative")
+		}
+	}
+	if c.SeatReservationMaxTTL < c.SeatReservationTTL {
+		add("seat_reservation_max_ttl", "must not be shorter than seat_reservation_ttl")
+	}
+	if c.AreaLoadPenalty < 0 {
+		add("area_load_penalty", "must not be negative")
+	}
+	if c.ChatHistorySize < 0 {
+		add("chat_history_size", "must not be negative")
+	}
+	if c.ChatMaxMessageLength <= 0 {
+		add("chat_max_message_length", "must be greater than 0")
+	}
+	if c.OutboxBatchSize <= 0 {
+		add("outbox_batch_size", "must be greater than 0")
+	}
+	if c.WebhookMaxAttempts <= 0 {
+		add("webhook_max_attempts", "must be greater than 0")
+	}
+	if c.WebhookRetryMaxDelay < c.WebhookRetryBaseDelay {
+		add("webhook_retry_max_delay", "must not be shorter than webhook_retry_base_delay")
+	}
+	for _, sink := range c.OutboxSinks {
+		if !validOutboxSink(sink) {
+			add("outbox_sinks", "unknown sink "+strconv.Quote(sink)+", expected stdout, file:<path>, redis-stream:<key> or webhook:<url>")
+		}
+	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		add("tls_key_file", "tls_cert_file and tls_key_file must be set together")
+	}
+	for _, f := range []struct{ field, path string }{
+		{"tls_cert_file", c.TLSCertFile},
+		{"tls_key_file", c.TLSKeyFile},
+		{"tls_client_ca_file", c.TLSClientCAFile},
+	} {
+		if f.path == "" {
+			continue
+		}
+		if _, err := os.Stat(f.path); err != nil {
+			add(f.field, "file is not readable")
+		}
+	}
+	switch c.TLSClientAuth {
+	case "", "none":
+	case "request", "require":
+		if !c.TLSEnabled() {
+			add("tls_client_auth", "requires tls_cert_file and tls_key_file")
+		}
+		if c.TLSClientCAFile == "" {
+			add("tls_client_ca_file", "is required when tls_client_auth is "+c.TLSClientAuth)
+		}
+	default:
+		add("tls_client_auth", "must be none, request or require")
+	}
+
+	durations := []struct {
+		field string
+		value time.Duration
+	}{
+		{"mongodb_connect_timeout", c.MongoConnectTimeout},
+		{"cache_ttl", c.CacheTTL},
+		{"request_timeout", c.RequestTimeout},
+		{"shutdown_timeout", c.ShutdownTimeout},
+		{"health_check_interval", c.HealthCheckInterval},
+		{"config_reload_interval", c.ConfigReloadInterval},
+		{"history_sample_interval", c.HistorySampleInterval},
+		{"history_raw_retention", c.HistoryRawRetention},
+		{"history_retention", c.HistoryRetention},
+		{"leaderboard_persist_interval", c.LeaderboardPersistInterval},
+		{"mode_schedule_interval", c.ModeScheduleInterval},
+		{"game_server_heartbeat_ttl", c.GameServerHeartbeatTTL},
+		{"player_latency_ttl", c.PlayerLatencyTTL},
+		{"seat_reservation_ttl", c.SeatReservationTTL},
+		{"seat_reservation_max_ttl", c.SeatReservationMaxTTL},
+		{"idempotency_window", c.IdempotencyWindow},
+		{"outbox_relay_interval", c.OutboxRelayInterval},
+		{"outbox_retention", c.OutboxRetention},
+		{"webhook_delivery_interval", c.WebhookDeliveryInterval},
+		{"webhook_timeout", c.WebhookTimeout},
+		{"webhook_retry_base_delay", c.WebhookRetryBaseDelay},
+		{"webhook_retry_max_delay", c.WebhookRetryMaxDelay},
+		{"session_eviction_interval", c.SessionEvictionInterval},
+	}
+	for _, d := range durations {
+		if d.value <= 0 {
+			add(d.field, "must be a positive duration")
+		}
+	}
+
+	return errs
+}
+
+func validPort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n <= 65535
+}
+
+func validOutboxSink(spec string) bool {
+	kind, target, _ := strings.Cut(spec, ":")
+	switch kind {
+	case "stdout":
+		return target == ""
+	case "file", "redis-stream":
+		return target != ""
+	case "webhook":
+		u, err := url.Parse(target)
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"net/http"
+
+	"multiplayer-webservice/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminConfig serves the effective configuration: the redacted settings the process started with
+// and the hot-reloadable settings currently in force.
+func AdminConfig(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"startup": config.AppConfig.Effective(),
+		"runtime": config.Runtime(),
+	})
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"unicode/utf8"
+
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func chatMessageToProto(msg logic.ChatMessage, history bool) *proto.ChatServerMessage {
+	return &proto.ChatServerMessage{Payload: &proto.ChatServerMessage_Message{Message: &proto.ChatMessage{
+		Id:       msg.ID,
+		ModeName: msg.ModeName,
+		PlayerId: msg.PlayerID,
+		Text:     msg.Text,
+		SentAt:   timestamppb.New(msg.SentAt),
+		History:  history,
+	}}}
+}
+
+// chatError ends a chat stream; players who are not, or are no longer, in the mode are refused.
+func chatError(err error, message string) error {
+	if errors.Is(err, logic.ErrPlayerNotInMode) {
+		return status.Errorf(codes.PermissionDenied, "%s: only players in the mode can chat", message)
+	}
+	return logicError(err, message)
+}
+
+// Chat relays messages between the players of a mode. The first client message must be a join;
+// the server then replays the recent history and streams every new message until the client
+// closes its side.
+func (s *MultiplayerService) Chat(stream proto.MultiplayerService_ChatServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	join := first.GetJoin()
+	if join.GetModeName() == "" || join.GetPlayerId() == "" {
+		return status.Errorf(codes.InvalidArgument, "The first message must be a join with mode_name and player_id")
+	}
+
+	sub, err := logic.SubscribeChatLogic(ctx, s.Collection, s.RedisCache, join.GetModeName(), join.GetPlayerId(), config.AppConfig.ChatHistorySize)
+	if err != nil {
+		return chatError(err, "Failed to join chat")
+	}
+	defer sub.Close()
+	for _, msg := range sub.History {
+		if err := stream.Send(chatMessageToProto(msg, true)); err != nil {
+			return err
+		}
+	}
+
+	// Only this goroutine sends on the stream; the receiver hands its notices over
+	notices := make(chan *proto.ChatNotice)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.receiveChat(ctx, stream, join, notices)
+	}()
+	for {
+		select {
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "Chat subscription closed")
+			}
+			if err := stream.Send(chatMessageToProto(msg, false)); err != nil {
+				return err
+			}
+		case notice := <-notices:
+			if err := stream.Send(&proto.ChatServerMessage{Payload: &proto.ChatServerMessage_Notice{Notice: notice}}); err != nil {
+				return err
+			}
+		case err := <-done:
+			return err
+		}
+	}
+}
+
+// receiveChat sends each text the client writes until the client closes its side of the stream.
+// Messages that are not delivered are reported back as notices instead of ending the stream.
+func (s *MultiplayerService) receiveChat(ctx context.Context, stream proto.MultiplayerService_ChatServer, join *proto.ChatJoin, notices chan<- *proto.ChatNotice) error {
+	notify := func(code, message string) error {
+		select {
+		case notices <- &proto.ChatNotice{Code: code, Message: message}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if in.GetJoin() != nil {
+			return status.Errorf(codes.InvalidArgument, "Only the first message may be a join")
+		}
+		text := in.GetText()
+		if text == "" {
+			continue
+		}
+		if utf8.RuneCountInString(text) > config.AppConfig.ChatMaxMessageLength {
+			if err := notify("too_long", "Message is longer than the chat limit"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		_, err = logic.SendChatMessageLogic(ctx, s.Collection, s.RedisCache, s.ChatHooks, join.GetModeName(), join.GetPlayerId(), text, config.AppConfig.ChatHistorySize)
+		switch {
+		case err == nil:
+		case errors.Is(err, logic.ErrPlayerMuted):
+			err = notify("muted", "You are muted in this mode")
+		case errors.Is(err, logic.ErrMessageRejected):
+			err = notify("rejected", err.Error())
+		default:
+			return chatError(err, "Failed to send chat message")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
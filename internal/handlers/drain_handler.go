@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultDrainRetryAfter is the retry hint given to rejected joins when DrainMode does not set one.
+const defaultDrainRetryAfter = 5 * time.Minute
+
+// DrainMode stops new joins to a mode while current players finish; only admin identities may call it
+func (s *MultiplayerService) DrainMode(ctx context.Context, req *proto.DrainModeRequest) (*proto.DrainModeResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	retryAfter := defaultDrainRetryAfter
+	if req.GetRetryAfter() != nil {
+		retryAfter = req.GetRetryAfter().AsDuration()
+	}
+	if retryAfter <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retry_after must be positive")
+	}
+
+	activeUsers, err := logic.DrainModeLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), retryAfter, req.GetReason())
+	if err != nil {
+		return nil, logicError(err, "Failed to drain mode")
+	}
+	return &proto.DrainModeResponse{Message: "Mode is draining", ActiveUsers: int32(activeUsers)}, nil
+}
+
+// ResumeMode lets a drained mode accept joins again; only admin identities may call it
+func (s *MultiplayerService) ResumeMode(ctx context.Context, req *proto.ResumeModeRequest) (*proto.ResumeModeResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+
+	if err := logic.ResumeModeLogic(ctx, s.Collection, req.GetModeName()); err != nil {
+		return nil, logicError(err, "Failed to resume mode")
+	}
+	return &proto.ResumeModeResponse{Message: "Mode resumed successfully"}, nil
+}
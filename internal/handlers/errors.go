@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"errors"
+
+	"multiplayer-webservice/internal/logic"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// logicError converts an error returned by the logic layer into a gRPC status error,
+// falling back to codes.Internal for anything that is not a known logic error.
+func logicError(err error, message string) error {
+	var draining *logic.ModeDrainingError
+	if errors.As(err, &draining) {
+		// Tell clients when to come back through the standard RetryInfo detail
+		st := status.Newf(codes.Unavailable, "%s: %v", message, err)
+		if detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(draining.RetryAfter)}); detailErr == nil {
+			st = detailed
+		}
+		return st.Err()
+	}
+
+	code := codes.Internal
+	switch {
+	case errors.Is(err, logic.ErrModeNotFound), errors.Is(err, logic.ErrPlayerNotRanked), errors.Is(err, logic.ErrSeasonNotFound), errors.Is(err, logic.ErrPlayerNotInMode),
+		errors.Is(err, logic.ErrGameStateNotRecorded), errors.Is(err, logic.ErrServerNotFound), errors.Is(err, logic.ErrAllocationNotFound),
+		errors.Is(err, logic.ErrRegionNotFound), errors.Is(err, logic.ErrWebhookNotFound), errors.Is(err, logic.ErrWebhookDeliveryNotFound):
+		code = codes.NotFound
+	case errors.Is(err, logic.ErrNoActiveSeason), errors.Is(err, logic.ErrSeasonClosed), errors.Is(err, logic.ErrModeUnavailable),
+		errors.Is(err, logic.ErrNoLatencies), errors.Is(err, logic.ErrReservationInvalid), errors.Is(err, logic.ErrWebhookDeliveryNotDead):
+		code = codes.FailedPrecondition
+	case errors.Is(err, logic.ErrSeasonExists), errors.Is(err, logic.ErrSeasonOverlap):
+		code = codes.AlreadyExists
+	case errors.Is(err, logic.ErrPlayerBanned):
+		code = codes.PermissionDenied
+	case errors.Is(err, logic.ErrInvalidSchedule), errors.Is(err, logic.ErrInvalidRegion), errors.Is(err, logic.ErrInvalidWebhook):
+		code = codes.InvalidArgument
+	case errors.Is(err, logic.ErrModeFull), errors.Is(err, logic.ErrSpectatorsFull):
+		code = codes.ResourceExhausted
+	case errors.Is(err, logic.ErrGameStateConflict):
+		code = codes.Aborted
+	case errors.Is(err, logic.ErrNoServerAvailable):
+		code = codes.Unavailable
+	}
+	return status.Errorf(code, "%s: %v", message, err)
+}
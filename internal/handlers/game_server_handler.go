@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RegisterServer adds a dedicated game server to the registry and tells it how often to send heartbeats
+func (s *MultiplayerService) RegisterServer(ctx context.Context, req *proto.RegisterServerRequest) (*proto.RegisterServerResponse, error) {
+	if req.GetServerId() == "" || req.GetAddress() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both server_id and address are required")
+	}
+	if req.GetCapacity() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "capacity must be greater than 0")
+	}
+
+	server := logic.GameServer{
+		ServerID: req.GetServerId(),
+		Address:  req.GetAddress(),
+		AreaCode: req.GetAreaCode(),
+		Modes:    req.GetModes(),
+		Capacity: int(req.GetCapacity()),
+	}
+	if err := logic.RegisterServerLogic(ctx, s.Collection, server, time.Now()); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to register server: %v", err)
+	}
+	// A third of the TTL leaves room for two lost heartbeats before eviction
+	interval := config.AppConfig.GameServerHeartbeatTTL / 3
+	return &proto.RegisterServerResponse{Message: "Server registered successfully", HeartbeatInterval: durationpb.New(interval)}, nil
+}
+
+// ServerHeartbeat keeps a registered game server allocatable
+func (s *MultiplayerService) ServerHeartbeat(ctx context.Context, req *proto.ServerHeartbeatRequest) (*proto.ServerHeartbeatResponse, error) {
+	if req.GetServerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "server_id is required")
+	}
+	if err := logic.ServerHeartbeatLogic(ctx, s.Collection, req.GetServerId(), time.Now()); err != nil {
+		return nil, logicError(err, "Failed to record heartbeat")
+	}
+	return &proto.ServerHeartbeatResponse{Message: "Heartbeat recorded"}, nil
+}
+
+// DeregisterServer removes a game server from the registry
+func (s *MultiplayerService) DeregisterServer(ctx context.Context, req *proto.DeregisterServerRequest) (*proto.DeregisterServerResponse, error) {
+	if req.GetServerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "server_id is required")
+	}
+	if err := logic.DeregisterServerLogic(ctx, s.Collection, req.GetServerId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to deregister server: %v", err)
+	}
+	return &proto.DeregisterServerResponse{Message: "Server deregistered successfully"}, nil
+}
+
+// AllocateServer reserves a slot on the least-loaded healthy server for a match of a mode
+func (s *MultiplayerService) AllocateServer(ctx context.Context, req *proto.AllocateServerRequest) (*proto.AllocateServerResponse, error) {
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	allocation, err := logic.AllocateServerLogic(ctx, s.Collection, req.GetModeName(), req.GetAreaCode(), config.AppConfig.GameServerHeartbeatTTL, time.Now())
+	if err != nil {
+		return nil, logicError(err, "Failed to allocate server")
+	}
+	return &proto.AllocateServerResponse{
+		AllocationId: allocation.AllocationID,
+		ServerId:     allocation.ServerID,
+		Address:      allocation.Address,
+		AreaCode:     allocation.AreaCode,
+	}, nil
+}
+
+// ReleaseServer frees the slot reserved by an allocation
+func (s *MultiplayerService) ReleaseServer(ctx context.Context, req *proto.ReleaseServerRequest) (*proto.ReleaseServerResponse, error) {
+	if req.GetServerId() == "" || req.GetAllocationId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both server_id and allocation_id are required")
+	}
+	if err := logic.ReleaseServerLogic(ctx, s.Collection, req.GetServerId(), req.GetAllocationId()); err != nil {
+		return nil, logicError(err, "Failed to release server")
+	}
+	return &proto.ReleaseServerResponse{Message: "Allocation released successfully"}, nil
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultGameStateHistoryLimit = 100
+	maxGameStateHistoryLimit     = 1000
+)
+
+// GetGameStateHistory returns the accepted game state updates of a mode, oldest first; only admin identities may call it
+func (s *MultiplayerService) GetGameStateHistory(ctx context.Context, req *proto.GameStateHistoryRequest) (*proto.GameStateHistoryResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	limit := int64(req.GetLimit())
+	if limit == 0 {
+		limit = defaultGameStateHistoryLimit
+	}
+	if limit < 0 || limit > maxGameStateHistoryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxGameStateHistoryLimit)
+	}
+	// Unset bounds stay zero so the range is open on that side
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, status.Errorf(codes.InvalidArgument, "to must not be before from")
+	}
+
+	states, err := logic.GetGameStateHistoryLogic(ctx, s.Collection, req.GetModeName(), from, to, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch game state history: %v", err)
+	}
+	resp := &proto.GameStateHistoryResponse{}
+	for i := range states {
+		state, err := gameStateToProto(&states[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to encode game state: %v", err)
+		}
+		resp.States = append(resp.States, state)
+	}
+	return resp, nil
+}
+
+// GetGameStateAt returns the game state a mode was in at a point in time; only admin identities may call it
+func (s *MultiplayerService) GetGameStateAt(ctx context.Context, req *proto.GameStateAtRequest) (*proto.GameStateResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetModeName() == "" || req.GetAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Both mode_name and at are required")
+	}
+
+	state, err := logic.GetGameStateAtLogic(ctx, s.Collection, req.GetModeName(), req.GetAt().AsTime())
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch game state")
+	}
+	resp, err := gameStateToProto(state)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to encode game state: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"multiplayer-webservice/internal/health"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Healthz is the liveness probe: it only reports that the process is able to serve HTTP.
+func Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": health.StatusUp})
+}
+
+// Readyz returns the readiness probe, which pings MongoDB and Redis on every call.
+// A degraded service (cache unavailable) is still ready; a missing database is not.
+func Readyz(checker *health.Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := checker.Check(c.Request.Context())
+		code := http.StatusOK
+		if report.Status == health.StatusDown {
+			code = http.StatusServiceUnavailable
+		}
+		c.JSON(code, report)
+	}
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxHistoryBuckets bounds the size of a GetActiveUsersHistory response.
+const maxHistoryBuckets = 10000
+
+// GetActiveUsersHistory returns the active-user time series of a mode or an area code
+func (s *MultiplayerService) GetActiveUsersHistory(ctx context.Context, req *proto.ActiveUsersHistoryRequest) (*proto.ActiveUsersHistoryResponse, error) {
+	scope, key := logic.HistoryScopeMode, req.GetModeName()
+	if req.GetAreaCode() != "" {
+		scope, key = logic.HistoryScopeArea, req.GetAreaCode()
+	}
+	if (req.GetModeName() == "") == (req.GetAreaCode() == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Exactly one of mode_name or area_code is required")
+	}
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Both from and to are required")
+	}
+	from, to := req.GetFrom().AsTime(), req.GetTo().AsTime()
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+	step := time.Hour
+	if req.GetStep() != nil {
+		step = req.GetStep().AsDuration()
+	}
+	if step <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "step must be positive")
+	}
+	if to.Sub(from)/step > maxHistoryBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "Window contains more than %d steps, use a larger step", maxHistoryBuckets)
+	}
+
+	history, err := logic.GetActiveUsersHistoryLogic(ctx, s.Collection, scope, key, from, to, step)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch active users history: %v", err)
+	}
+
+	resp := &proto.ActiveUsersHistoryResponse{PeakActiveUsers: int32(history.Peak)}
+	if !history.PeakTime.IsZero() {
+		resp.PeakTime = timestamppb.New(history.PeakTime)
+	}
+	for _, point := range history.Points {
+		resp.Points = append(resp.Points, &proto.ActiveUsersHistoryPoint{
+			Time:               timestamppb.New(point.Time),
+			AverageActiveUsers: point.Average,
+			PeakActiveUsers:    int32(point.Peak),
+		})
+	}
+	return resp, nil
+}
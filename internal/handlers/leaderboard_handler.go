@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTopN         = 10
+	defaultAroundRadius = 5
+	maxLeaderboardPage  = 1000
+)
+
+// leaderboardFromRequest validates a LeaderboardId and converts it for the logic layer.
+func leaderboardFromRequest(id *proto.LeaderboardId) (logic.Leaderboard, error) {
+	if id.GetModeName() == "" {
+		return logic.Leaderboard{}, status.Errorf(codes.InvalidArgument, "leaderboard.mode_name is required")
+	}
+	return logic.Leaderboard{ModeName: id.GetModeName(), AreaCode: id.GetAreaCode(), Season: id.GetSeason()}, nil
+}
+
+func leaderboardEntryToProto(entry logic.LeaderboardEntry) *proto.LeaderboardEntry {
+	return &proto.LeaderboardEntry{PlayerId: entry.PlayerID, Score: entry.Score, Rank: entry.Rank}
+}
+
+func leaderboardResponse(entries []logic.LeaderboardEntry) *proto.LeaderboardResponse {
+	resp := &proto.LeaderboardResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, leaderboardEntryToProto(entry))
+	}
+	return resp
+}
+
+// SubmitScore records a player's score, keeping the best score per player
+func (s *MultiplayerService) SubmitScore(ctx context.Context, req *proto.SubmitScoreRequest) (*proto.SubmitScoreResponse, error) {
+	board, err := leaderboardFromRequest(req.GetLeaderboard())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "player_id is required")
+	}
+
+	entry, err := logic.SubmitScoreLogic(ctx, s.Collection, s.RedisCache, board, req.GetPlayerId(), req.GetScore())
+	if err != nil {
+		return nil, logicError(err, "Failed to submit score")
+	}
+	return &proto.SubmitScoreResponse{Entry: leaderboardEntryToProto(*entry)}, nil
+}
+
+// GetTopN returns the best entries of a leaderboard
+func (s *MultiplayerService) GetTopN(ctx context.Context, req *proto.GetTopNRequest) (*proto.LeaderboardResponse, error) {
+	board, err := leaderboardFromRequest(req.GetLeaderboard())
+	if err != nil {
+		return nil, err
+	}
+	n := int64(req.GetN())
+	if n == 0 {
+		n = defaultTopN
+	}
+	if n < 0 || n > maxLeaderboardPage {
+		return nil, status.Errorf(codes.InvalidArgument, "n must be between 1 and %d", maxLeaderboardPage)
+	}
+
+	entries, err := logic.GetTopNLogic(ctx, s.Collection, s.RedisCache, board, n)
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch leaderboard")
+	}
+	return leaderboardResponse(entries), nil
+}
+
+// GetPlayerRank returns a player's score and rank on a leaderboard
+func (s *MultiplayerService) GetPlayerRank(ctx context.Context, req *proto.GetPlayerRankRequest) (*proto.GetPlayerRankResponse, error) {
+	board, err := leaderboardFromRequest(req.GetLeaderboard())
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := logic.GetPlayerRankLogic(ctx, s.Collection, s.RedisCache, board, req.GetPlayerId())
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch player rank")
+	}
+	return &proto.GetPlayerRankResponse{Entry: leaderboardEntryToProto(*entry)}, nil
+}
+
+// GetAroundPlayer returns the entries ranked around a player
+func (s *MultiplayerService) GetAroundPlayer(ctx context.Context, req *proto.GetAroundPlayerRequest) (*proto.LeaderboardResponse, error) {
+	board, err := leaderboardFromRequest(req.GetLeaderboard())
+	if err != nil {
+		return nil, err
+	}
+	radius := int64(req.GetRadius())
+	if radius == 0 {
+		radius = defaultAroundRadius
+	}
+	if radius < 0 || radius > maxLeaderboardPage/2 {
+		return nil, status.Errorf(codes.InvalidArgument, "radius must be between 1 and %d", maxLeaderboardPage/2)
+	}
+
+	entries, err := logic.GetAroundPlayerLogic(ctx, s.Collection, s.RedisCache, board, req.GetPlayerId(), radius)
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch leaderboard")
+	}
+	return leaderboardResponse(entries), nil
+}
@@ -2,25 +2,29 @@ package handlers
 
 import (
 	"context"
-	"log"
 
 	// "time"
 
+	"multiplayer-webservice/internal/auth"
 	"multiplayer-webservice/internal/cache"
 	"multiplayer-webservice/internal/logic"
 	"multiplayer-webservice/internal/proto"
 
-	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MultiplayerService struct {
 	proto.UnimplementedMultiplayerServiceServer
 	Collection *mongo.Collection
 	RedisCache *cache.RedisCache
+	// Authorizer guards the administrative RPCs; nil allows every caller.
+	Authorizer *auth.Authorizer
+	// ChatHooks filter chat messages and enforce mutes; the zero value applies neither.
+	ChatHooks logic.ChatHooks
 }
 
 // NewMultiplayerService initializes a new instance of MultiplayerService.
@@ -41,11 +45,11 @@ func (s *MultiplayerService) GetModeUsage(ctx context.Context, req *proto.ModeUs
 	return &proto.ModeUsageResponse{Modes: modes}, nil
 }
 
-// JoinMode adds a player to a mode.
+// JoinMode adds a player to a mode, taking their reserved seat when a reservation token is given.
 func (s *MultiplayerService) JoinMode(ctx context.Context, req *proto.JoinModeRequest) (*proto.JoinModeResponse, error) {
-	err := logic.JoinModeLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetPlayerId())
+	err := logic.JoinModeWithReservationLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetPlayerId(), req.GetReservationToken())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to join mode: %v", err)
+		return nil, logicError(err, "Failed to join mode")
 	}
 	return &proto.JoinModeResponse{Message: "Player added successfully"}, nil
 }
@@ -59,27 +63,6 @@ func (s *MultiplayerService) LeaveMode(ctx context.Context, req *proto.LeaveMode
 	return &proto.LeaveModeResponse{Message: "Player removed successfully"}, nil
 }
 
-// GetTotalActiveUsers fetches total active users
-func getTotalActiveUsers(c *gin.Context) {
-    conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
-    if err != nil {
-        log.Printf("gRPC connection error: %v", err)
-        c.JSON(500, gin.H{"message": "Failed to connect to gRPC server"})
-        return
-    }
-    defer conn.Close()
-
-    client := proto.NewMultiplayerServiceClient(conn)
-    resp, err := client.GetTotalActiveUsers(context.Background(), &proto.TotalActiveUsersRequest{})
-    if err != nil {
-        log.Printf("gRPC request error: %v", err)
-        c.JSON(500, gin.H{"message": "Failed to fetch total active users"})
-        return
-    }
-
-    c.JSON(200, gin.H{"totalActiveUsers": resp.TotalActiveUsers})
-}
-
 // GetModeDetails fetches mode details
 func (s *MultiplayerService) GetModeDetails(ctx context.Context, req *proto.ModeDetailsRequest) (*proto.ModeDetailsResponse, error) {
 	modeDetails, err := logic.GetModeDetailsLogic(ctx, s.Collection, s.RedisCache, req.GetModeName())
@@ -98,8 +81,20 @@ func (s *MultiplayerService) GetActiveUsersByAreaCode(ctx context.Context, req *
 	return &proto.ActiveUsersByAreaCodeResponse{TotalActiveUsers: totalUsers}, nil
 }
 
-// GetGameModeStats fetches game mode stats
+// GetGameModeStats fetches game mode stats, for every mode or for the modes of one area and the areas below it
 func (s *MultiplayerService) GetGameModeStats(ctx context.Context, req *proto.GameModeStatsRequest) (*proto.GameModeStatsResponse, error) {
+	if req.GetAreaCode() != "" {
+		stats, err := logic.GetAreaStatsLogic(ctx, s.Collection, s.RedisCache, req.GetAreaCode())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to fetch game mode stats: %v", err)
+		}
+		return &proto.GameModeStatsResponse{
+			TotalModes:       stats.Modes,
+			TotalActiveUsers: stats.ActiveUsers,
+			TotalSpectators:  stats.Spectators,
+		}, nil
+	}
+
 	stats, err := logic.GetGameModeStatsLogic(ctx, s.Collection, s.RedisCache)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to fetch game mode stats: %v", err)
@@ -116,11 +111,56 @@ func (s *MultiplayerService) GetPlayers(ctx context.Context, req *proto.GetPlaye
 	return &proto.GetPlayersResponse{Players: players}, nil
 }
 
-// UpdateGameState modifies the game state of a mode
+// UpdateGameState modifies the game state of a mode, merging the structured state unless replace_state is set
 func (s *MultiplayerService) UpdateGameState(ctx context.Context, req *proto.UpdateGameStateRequest) (*proto.UpdateGameStateResponse, error) {
-	err := logic.UpdateGameStateLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetGameState())
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	patch := logic.GameStatePatch{
+		Status:          req.GetGameState(),
+		Data:            req.GetState().AsMap(),
+		Replace:         req.GetReplaceState(),
+		ExpectedVersion: req.ExpectedVersion,
+		UpdatedBy:       auth.Identity(ctx),
+	}
+	state, err := logic.PatchGameStateLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), patch)
+	if err != nil {
+		return nil, logicError(err, "Failed to update game state")
+	}
+	data, err := structpb.NewStruct(state.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to encode game state: %v", err)
+	}
+	return &proto.UpdateGameStateResponse{Message: "Game state updated successfully", Version: state.Version, State: data}, nil
+}
+
+// GetGameState returns the status and structured state of a mode with their version
+func (s *MultiplayerService) GetGameState(ctx context.Context, req *proto.GetGameStateRequest) (*proto.GameStateResponse, error) {
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	state, err := logic.GetGameStateLogic(ctx, s.Collection, req.GetModeName())
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch game state")
+	}
+	resp, err := gameStateToProto(state)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to encode game state: %v", err)
+	}
+	return resp, nil
+}
+
+func gameStateToProto(state *logic.GameState) (*proto.GameStateResponse, error) {
+	data, err := structpb.NewStruct(state.Data)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update game state: %v", err)
+		return nil, err
 	}
-	return &proto.UpdateGameStateResponse{Message: "Game state updated successfully"}, nil
+	return &proto.GameStateResponse{
+		ModeName:  state.ModeName,
+		GameState: state.Status,
+		State:     data,
+		Version:   state.Version,
+		UpdatedAt: timestamppb.New(state.UpdatedAt),
+		UpdatedBy: state.UpdatedBy,
+	}, nil
 }
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListOutboxConsumers reports how far each outbox sink has got; only admin identities may call it
+func (s *MultiplayerService) ListOutboxConsumers(ctx context.Context, req *proto.ListOutboxConsumersRequest) (*proto.ListOutboxConsumersResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	consumers, err := logic.ListOutboxConsumersLogic(ctx, s.Collection)
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch outbox consumers")
+	}
+	resp := &proto.ListOutboxConsumersResponse{}
+	for _, consumer := range consumers {
+		resp.Consumers = append(resp.Consumers, &proto.OutboxConsumer{
+			Name:      consumer.Name,
+			LastSeq:   consumer.LastSeq,
+			UpdatedAt: timestamppb.New(consumer.UpdatedAt),
+			Pending:   consumer.Pending,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReportedLatencyMs rejects obviously broken measurements
+const maxReportedLatencyMs = 60000
+
+func regionToProto(region logic.Region) *proto.Region {
+	return &proto.Region{
+		AreaCode:  region.AreaCode,
+		Name:      region.Name,
+		Parent:    region.Parent,
+		Endpoints: region.Endpoints,
+	}
+}
+
+// PutRegion adds or replaces a region of the catalog; only admin identities may call it
+func (s *MultiplayerService) PutRegion(ctx context.Context, req *proto.PutRegionRequest) (*proto.PutRegionResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	region := req.GetRegion()
+	if region.GetAreaCode() == "" || region.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both region.area_code and region.name are required")
+	}
+
+	err := logic.PutRegionLogic(ctx, s.Collection, s.RedisCache, logic.Region{
+		AreaCode:  region.GetAreaCode(),
+		Name:      region.GetName(),
+		Parent:    region.GetParent(),
+		Endpoints: region.GetEndpoints(),
+	})
+	if err != nil {
+		return nil, logicError(err, "Failed to store region")
+	}
+	return &proto.PutRegionResponse{Message: "Region stored successfully"}, nil
+}
+
+// DeleteRegion removes a region without child regions from the catalog; only admin identities may call it
+func (s *MultiplayerService) DeleteRegion(ctx context.Context, req *proto.DeleteRegionRequest) (*proto.DeleteRegionResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetAreaCode() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "area_code is required")
+	}
+	if err := logic.DeleteRegionLogic(ctx, s.Collection, s.RedisCache, req.GetAreaCode()); err != nil {
+		return nil, logicError(err, "Failed to delete region")
+	}
+	return &proto.DeleteRegionResponse{Message: "Region deleted successfully"}, nil
+}
+
+// ListRegions returns the region catalog
+func (s *MultiplayerService) ListRegions(ctx context.Context, req *proto.ListRegionsRequest) (*proto.ListRegionsResponse, error) {
+	regions, err := logic.ListRegionsLogic(ctx, s.Collection, s.RedisCache)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch regions: %v", err)
+	}
+	resp := &proto.ListRegionsResponse{}
+	for _, region := range regions {
+		resp.Regions = append(resp.Regions, regionToProto(region))
+	}
+	return resp, nil
+}
+
+// ReportLatencies records the round-trip times a player measured to each area
+func (s *MultiplayerService) ReportLatencies(ctx context.Context, req *proto.ReportLatenciesRequest) (*proto.ReportLatenciesResponse, error) {
+	if req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "player_id is required")
+	}
+	latencies := make(map[string]int, len(req.GetLatenciesMs()))
+	for area, ms := range req.GetLatenciesMs() {
+		if ms < 0 || ms > maxReportedLatencyMs {
+			return nil, status.Errorf(codes.InvalidArgument, "latency for %s must be between 0 and %d ms", area, maxReportedLatencyMs)
+		}
+		latencies[area] = int(ms)
+	}
+
+	err := logic.ReportLatenciesLogic(ctx, s.Collection, s.RedisCache, req.GetPlayerId(), latencies, config.AppConfig.PlayerLatencyTTL)
+	if err != nil {
+		if errors.Is(err, logic.ErrRegionNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown area: %v", err)
+		}
+		return nil, logicError(err, "Failed to store latencies")
+	}
+	return &proto.ReportLatenciesResponse{Message: "Latencies recorded successfully"}, nil
+}
+
+// RecommendArea picks the best area for a player by reported latency and the current load of the mode
+func (s *MultiplayerService) RecommendArea(ctx context.Context, req *proto.RecommendAreaRequest) (*proto.RecommendAreaResponse, error) {
+	if req.GetModeName() == "" || req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both mode_name and player_id are required")
+	}
+
+	candidates, err := logic.RecommendAreaLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetPlayerId(), config.AppConfig.AreaLoadPenalty)
+	if err != nil {
+		return nil, logicError(err, "Failed to recommend area")
+	}
+	resp := &proto.RecommendAreaResponse{Recommended: regionToProto(candidates[0].Region)}
+	for _, candidate := range candidates {
+		resp.Candidates = append(resp.Candidates, &proto.AreaCandidate{
+			Region:      regionToProto(candidate.Region),
+			LatencyMs:   int32(candidate.LatencyMs),
+			ActiveUsers: candidate.ActiveUsers,
+			Score:       candidate.Score,
+		})
+	}
+	return resp, nil
+}
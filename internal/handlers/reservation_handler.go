@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReserveSeat holds a seat in a mode for a player who is about to join
+func (s *MultiplayerService) ReserveSeat(ctx context.Context, req *proto.ReserveSeatRequest) (*proto.ReserveSeatResponse, error) {
+	if req.GetModeName() == "" || req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both mode_name and player_id are required")
+	}
+	ttl := config.AppConfig.SeatReservationTTL
+	if req.GetTtl() != nil {
+		ttl = req.GetTtl().AsDuration()
+		if ttl <= 0 || ttl > config.AppConfig.SeatReservationMaxTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be positive and at most %s", config.AppConfig.SeatReservationMaxTTL)
+		}
+	}
+
+	reservation, err := logic.ReserveSeatLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetPlayerId(), ttl)
+	if err != nil {
+		return nil, logicError(err, "Failed to reserve seat")
+	}
+	return &proto.ReserveSeatResponse{
+		ReservationToken: reservation.Token,
+		ExpiresAt:        timestamppb.New(reservation.ExpiresAt),
+	}, nil
+}
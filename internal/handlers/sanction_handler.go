@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/auth"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSanctionsLimit = 100
+	maxSanctionsLimit     = 1000
+)
+
+func sanctionToProto(sanction logic.Sanction) *proto.Sanction {
+	out := &proto.Sanction{
+		Id:        sanction.ID.Hex(),
+		Type:      sanction.Type,
+		PlayerId:  sanction.PlayerID,
+		ModeName:  sanction.ModeName,
+		Reason:    sanction.Reason,
+		IssuedBy:  sanction.IssuedBy,
+		CreatedAt: timestamppb.New(sanction.CreatedAt),
+	}
+	if sanction.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(*sanction.ExpiresAt)
+	}
+	return out
+}
+
+// KickPlayer removes a player from a mode; only admin identities may call it
+func (s *MultiplayerService) KickPlayer(ctx context.Context, req *proto.KickPlayerRequest) (*proto.KickPlayerResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetModeName() == "" || req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both mode_name and player_id are required")
+	}
+
+	err := logic.KickPlayerLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetPlayerId(), req.GetReason(), auth.Identity(ctx))
+	if err != nil {
+		return nil, logicError(err, "Failed to kick player")
+	}
+	return &proto.KickPlayerResponse{Message: "Player kicked successfully"}, nil
+}
+
+// BanPlayer bans a player from one or every mode, permanently or for a duration; only admin identities may call it
+func (s *MultiplayerService) BanPlayer(ctx context.Context, req *proto.BanPlayerRequest) (*proto.BanPlayerResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "player_id is required")
+	}
+	var duration time.Duration
+	if req.GetDuration() != nil {
+		duration = req.GetDuration().AsDuration()
+		if duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "duration must be positive, or unset for a permanent ban")
+		}
+	}
+
+	ban, err := logic.BanPlayerLogic(ctx, s.Collection, s.RedisCache, req.GetPlayerId(), req.GetModeName(), duration, req.GetReason(), auth.Identity(ctx))
+	if err != nil {
+		return nil, logicError(err, "Failed to ban player")
+	}
+	return &proto.BanPlayerResponse{Ban: sanctionToProto(*ban)}, nil
+}
+
+// MutePlayer stops a player chatting in one or every mode, permanently or for a duration; only admin identities may call it
+func (s *MultiplayerService) MutePlayer(ctx context.Context, req *proto.MutePlayerRequest) (*proto.MutePlayerResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetPlayerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "player_id is required")
+	}
+	var duration time.Duration
+	if req.GetDuration() != nil {
+		duration = req.GetDuration().AsDuration()
+		if duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "duration must be positive, or unset for a permanent mute")
+		}
+	}
+
+	mute, err := logic.MutePlayerLogic(ctx, s.Collection, req.GetPlayerId(), req.GetModeName(), duration, req.GetReason(), auth.Identity(ctx))
+	if err != nil {
+		return nil, logicError(err, "Failed to mute player")
+	}
+	return &proto.MutePlayerResponse{Mute: sanctionToProto(*mute)}, nil
+}
+
+// ListSanctions returns kicks, bans and mutes, newest first; only admin identities may call it
+func (s *MultiplayerService) ListSanctions(ctx context.Context, req *proto.ListSanctionsRequest) (*proto.ListSanctionsResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit == 0 {
+		limit = defaultSanctionsLimit
+	}
+	if limit < 0 || limit > maxSanctionsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxSanctionsLimit)
+	}
+
+	sanctions, err := logic.ListSanctionsLogic(ctx, s.Collection, logic.SanctionFilter{
+		PlayerID:       req.GetPlayerId(),
+		ModeName:       req.GetModeName(),
+		IncludeExpired: req.GetIncludeExpired(),
+		Limit:          limit,
+	}, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch sanctions: %v", err)
+	}
+
+	resp := &proto.ListSanctionsResponse{}
+	for _, sanction := range sanctions {
+		resp.Sanctions = append(resp.Sanctions, sanctionToProto(sanction))
+	}
+	return resp, nil
+}
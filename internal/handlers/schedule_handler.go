@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetModeSchedule sets or removes the availability windows of a mode; only admin identities may call it
+func (s *MultiplayerService) SetModeSchedule(ctx context.Context, req *proto.SetModeScheduleRequest) (*proto.SetModeScheduleResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+
+	var schedule *logic.ModeSchedule
+	if in := req.GetSchedule(); in != nil {
+		schedule = &logic.ModeSchedule{
+			TimeZone:    in.GetTimeZone(),
+			OpenState:   in.GetOpenState(),
+			ClosedState: in.GetClosedState(),
+		}
+		if in.GetStartsAt() != nil {
+			startsAt := in.GetStartsAt().AsTime()
+			schedule.StartsAt = &startsAt
+		}
+		if in.GetEndsAt() != nil {
+			endsAt := in.GetEndsAt().AsTime()
+			schedule.EndsAt = &endsAt
+		}
+		for _, window := range in.GetWeekly() {
+			schedule.Weekly = append(schedule.Weekly, logic.WeeklyWindow{
+				Weekday: time.Weekday(window.GetWeekday()),
+				Start:   window.GetStart(),
+				End:     window.GetEnd(),
+			})
+		}
+	}
+
+	if err := logic.SetModeScheduleLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), schedule); err != nil {
+		return nil, logicError(err, "Failed to set mode schedule")
+	}
+	return &proto.SetModeScheduleResponse{Message: "Mode schedule updated successfully"}, nil
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultStandingsLimit = 100
+	maxStandingsLimit     = 1000
+)
+
+func seasonToProto(season logic.Season) *proto.Season {
+	return &proto.Season{
+		SeasonId: season.SeasonID,
+		Name:     season.Name,
+		Modes:    season.Modes,
+		StartsAt: timestamppb.New(season.StartsAt),
+		EndsAt:   timestamppb.New(season.EndsAt),
+		Archived: season.ArchivedAt != nil,
+	}
+}
+
+// CreateSeason stores a new season definition; only admin identities may call it
+func (s *MultiplayerService) CreateSeason(ctx context.Context, req *proto.CreateSeasonRequest) (*proto.CreateSeasonResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	season := req.GetSeason()
+	if season.GetSeasonId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "season.season_id is required")
+	}
+	if season.GetStartsAt() == nil || season.GetEndsAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Both season.starts_at and season.ends_at are required")
+	}
+	startsAt, endsAt := season.GetStartsAt().AsTime(), season.GetEndsAt().AsTime()
+	if !startsAt.Before(endsAt) {
+		return nil, status.Errorf(codes.InvalidArgument, "season.starts_at must be before season.ends_at")
+	}
+
+	err := logic.CreateSeasonLogic(ctx, s.Collection, logic.Season{
+		SeasonID: season.GetSeasonId(),
+		Name:     season.GetName(),
+		Modes:    season.GetModes(),
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	})
+	if err != nil {
+		return nil, logicError(err, "Failed to create season")
+	}
+	return &proto.CreateSeasonResponse{Message: "Season created successfully"}, nil
+}
+
+// ListSeasons returns the seasons covering a mode, most recent first
+func (s *MultiplayerService) ListSeasons(ctx context.Context, req *proto.ListSeasonsRequest) (*proto.ListSeasonsResponse, error) {
+	seasons, err := logic.ListSeasonsLogic(ctx, s.Collection, req.GetModeName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch seasons: %v", err)
+	}
+
+	resp := &proto.ListSeasonsResponse{}
+	for _, season := range seasons {
+		resp.Seasons = append(resp.Seasons, seasonToProto(season))
+	}
+	return resp, nil
+}
+
+// RecordMatchOutcome adds the results of a match to the players' season records
+func (s *MultiplayerService) RecordMatchOutcome(ctx context.Context, req *proto.RecordMatchOutcomeRequest) (*proto.RecordMatchOutcomeResponse, error) {
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	if len(req.GetResults()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "At least one result is required")
+	}
+
+	results := make([]logic.MatchResult, 0, len(req.GetResults()))
+	for _, result := range req.GetResults() {
+		if result.GetPlayerId() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Every result needs a player_id")
+		}
+		results = append(results, logic.MatchResult{PlayerID: result.GetPlayerId(), Won: result.GetWon(), Points: result.GetPoints()})
+	}
+
+	seasonID, err := logic.RecordMatchOutcomeLogic(ctx, s.Collection, req.GetModeName(), req.GetSeasonId(), results, time.Now())
+	if err != nil {
+		return nil, logicError(err, "Failed to record match outcome")
+	}
+	return &proto.RecordMatchOutcomeResponse{SeasonId: seasonID}, nil
+}
+
+// GetSeasonStandings returns the standings of a mode in the current or a past season
+func (s *MultiplayerService) GetSeasonStandings(ctx context.Context, req *proto.SeasonStandingsRequest) (*proto.SeasonStandingsResponse, error) {
+	if req.GetModeName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mode_name is required")
+	}
+	limit := int64(req.GetLimit())
+	if limit == 0 {
+		limit = defaultStandingsLimit
+	}
+	if limit < 0 || limit > maxStandingsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxStandingsLimit)
+	}
+
+	season, records, err := logic.GetSeasonStandingsLogic(ctx, s.Collection, req.GetModeName(), req.GetSeasonId(), limit, time.Now())
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch season standings")
+	}
+
+	resp := &proto.SeasonStandingsResponse{Season: seasonToProto(*season)}
+	for _, record := range records {
+		resp.Standings = append(resp.Standings, &proto.SeasonRecord{
+			PlayerId: record.PlayerID,
+			Wins:     record.Wins,
+			Losses:   record.Losses,
+			Points:   record.Points,
+			Rank:     record.Rank,
+		})
+	}
+	return resp, nil
+}
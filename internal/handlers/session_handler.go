@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSessionStats returns average and median session length per mode or per area code
+func (s *MultiplayerService) GetSessionStats(ctx context.Context, req *proto.SessionStatsRequest) (*proto.SessionStatsResponse, error) {
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Both from and to are required")
+	}
+	from, to := req.GetFrom().AsTime(), req.GetTo().AsTime()
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+
+	groupBy := logic.SessionGroupByMode
+	if req.GetGroupBy() == proto.SessionStatsRequest_AREA {
+		groupBy = logic.SessionGroupByArea
+	}
+
+	stats, err := logic.GetSessionStatsLogic(ctx, s.Collection, groupBy, req.GetKey(), from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch session stats: %v", err)
+	}
+
+	resp := &proto.SessionStatsResponse{}
+	for _, stat := range stats {
+		resp.Stats = append(resp.Stats, &proto.SessionStats{
+			Key:            stat.Key,
+			SessionCount:   int32(stat.SessionCount),
+			AverageSeconds: stat.AverageSeconds,
+			MedianSeconds:  stat.MedianSeconds,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JoinAsSpectator lets a user watch a mode without taking a player slot
+func (s *MultiplayerService) JoinAsSpectator(ctx context.Context, req *proto.SpectatorRequest) (*proto.SpectatorResponse, error) {
+	if req.GetModeName() == "" || req.GetSpectatorId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both mode_name and spectator_id are required")
+	}
+	if err := logic.JoinAsSpectatorLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetSpectatorId()); err != nil {
+		return nil, logicError(err, "Failed to join as spectator")
+	}
+	return &proto.SpectatorResponse{Message: "Spectator added successfully"}, nil
+}
+
+// LeaveSpectator stops a user watching a mode
+func (s *MultiplayerService) LeaveSpectator(ctx context.Context, req *proto.SpectatorRequest) (*proto.SpectatorResponse, error) {
+	if req.GetModeName() == "" || req.GetSpectatorId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Both mode_name and spectator_id are required")
+	}
+	if err := logic.LeaveSpectatorLogic(ctx, s.Collection, s.RedisCache, req.GetModeName(), req.GetSpectatorId()); err != nil {
+		return nil, logicError(err, "Failed to leave as spectator")
+	}
+	return &proto.SpectatorResponse{Message: "Spectator removed successfully"}, nil
+}
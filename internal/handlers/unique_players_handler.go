@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetUniquePlayers returns the daily or monthly unique players of a mode, an area code or both
+func (s *MultiplayerService) GetUniquePlayers(ctx context.Context, req *proto.UniquePlayersRequest) (*proto.UniquePlayersResponse, error) {
+	if req.GetModeName() == "" && req.GetAreaCode() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "At least one of mode_name or area_code is required")
+	}
+
+	period := logic.UniquePeriodDay
+	if req.GetPeriod() == proto.UniquePlayersRequest_MONTH {
+		period = logic.UniquePeriodMonth
+	}
+	date := time.Now()
+	if req.GetDate() != nil {
+		date = req.GetDate().AsTime()
+	}
+
+	count := logic.GetUniquePlayersLogic(ctx, s.RedisCache, req.GetModeName(), req.GetAreaCode(), period, date)
+	return &proto.UniquePlayersResponse{UniquePlayers: count}, nil
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/auth"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultWebhookDeliveriesLimit = 50
+	maxWebhookDeliveriesLimit     = 500
+)
+
+func webhookToProto(webhook logic.Webhook) *proto.Webhook {
+	return &proto.Webhook{
+		WebhookId: webhook.WebhookID,
+		Url:       webhook.URL,
+		ModeName:  webhook.ModeName,
+		Events:    webhook.Events,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+		CreatedBy: webhook.CreatedBy,
+	}
+}
+
+func webhookDeliveryToProto(delivery logic.WebhookDelivery) *proto.WebhookDelivery {
+	resp := &proto.WebhookDelivery{
+		DeliveryId: delivery.ID.Hex(),
+		WebhookId:  delivery.WebhookID,
+		EventId:    delivery.EventID,
+		EventType:  delivery.EventType,
+		ModeName:   delivery.ModeName,
+		Status:     delivery.Status,
+		Attempts:   int32(delivery.Attempts),
+		CreatedAt:  timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.Status == logic.WebhookDeliveryPending {
+		resp.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+	if delivery.DeliveredAt != nil {
+		resp.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+	for _, attempt := range delivery.AttemptLog {
+		resp.AttemptLog = append(resp.AttemptLog, &proto.WebhookAttempt{
+			At:         timestamppb.New(attempt.At),
+			StatusCode: int32(attempt.StatusCode),
+			Error:      attempt.Error,
+		})
+	}
+	return resp
+}
+
+// CreateWebhook subscribes a URL to mode events; only admin identities may call it
+func (s *MultiplayerService) CreateWebhook(ctx context.Context, req *proto.CreateWebhookRequest) (*proto.CreateWebhookResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetUrl() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url is required")
+	}
+
+	webhook, err := logic.CreateWebhookLogic(ctx, s.Collection, logic.Webhook{
+		URL:       req.GetUrl(),
+		Secret:    req.GetSecret(),
+		ModeName:  req.GetModeName(),
+		Events:    req.GetEvents(),
+		CreatedBy: auth.Identity(ctx),
+	})
+	if err != nil {
+		return nil, logicError(err, "Failed to create webhook")
+	}
+	return &proto.CreateWebhookResponse{Webhook: webhookToProto(*webhook), Secret: webhook.Secret}, nil
+}
+
+// DeleteWebhook removes a webhook and its deliveries; only admin identities may call it
+func (s *MultiplayerService) DeleteWebhook(ctx context.Context, req *proto.DeleteWebhookRequest) (*proto.DeleteWebhookResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetWebhookId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook_id is required")
+	}
+	if err := logic.DeleteWebhookLogic(ctx, s.Collection, req.GetWebhookId()); err != nil {
+		return nil, logicError(err, "Failed to delete webhook")
+	}
+	return &proto.DeleteWebhookResponse{Message: "Webhook deleted successfully"}, nil
+}
+
+// ListWebhooks returns the webhooks, optionally only those receiving events of one mode; only admin identities may call it
+func (s *MultiplayerService) ListWebhooks(ctx context.Context, req *proto.ListWebhooksRequest) (*proto.ListWebhooksResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	webhooks, err := logic.ListWebhooksLogic(ctx, s.Collection, req.GetModeName())
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch webhooks")
+	}
+	resp := &proto.ListWebhooksResponse{}
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(webhook))
+	}
+	return resp, nil
+}
+
+// ListWebhookDeliveries returns the delivery log, newest first; only admin identities may call it
+func (s *MultiplayerService) ListWebhookDeliveries(ctx context.Context, req *proto.ListWebhookDeliveriesRequest) (*proto.ListWebhookDeliveriesResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit == 0 {
+		limit = defaultWebhookDeliveriesLimit
+	}
+	if limit < 0 || limit > maxWebhookDeliveriesLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxWebhookDeliveriesLimit)
+	}
+	switch req.GetStatus() {
+	case "", logic.WebhookDeliveryPending, logic.WebhookDeliveryDelivered, logic.WebhookDeliveryDead:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "status must be pending, delivered or dead")
+	}
+
+	deliveries, err := logic.ListWebhookDeliveriesLogic(ctx, s.Collection, req.GetWebhookId(), req.GetStatus(), limit)
+	if err != nil {
+		return nil, logicError(err, "Failed to fetch webhook deliveries")
+	}
+	resp := &proto.ListWebhookDeliveriesResponse{}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, webhookDeliveryToProto(delivery))
+	}
+	return resp, nil
+}
+
+// RetryWebhookDelivery queues a dead-lettered delivery again; only admin identities may call it
+func (s *MultiplayerService) RetryWebhookDelivery(ctx context.Context, req *proto.RetryWebhookDeliveryRequest) (*proto.RetryWebhookDeliveryResponse, error) {
+	if err := s.Authorizer.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetDeliveryId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "delivery_id is required")
+	}
+	delivery, err := logic.RetryWebhookDeliveryLogic(ctx, s.Collection, req.GetDeliveryId(), time.Now())
+	if err != nil {
+		return nil, logicError(err, "Failed to retry webhook delivery")
+	}
+	return &proto.RetryWebhookDeliveryResponse{Delivery: webhookDeliveryToProto(*delivery)}, nil
+}
@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Status is the health of a single dependency or of the service as a whole.
+type Status string
+
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+// DependencyStatus is the result of pinging one backing service.
+type DependencyStatus struct {
+	Status    Status `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report is the aggregated result of a readiness check.
+type Report struct {
+	Status       Status                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+	CheckedAt    time.Time                   `json:"checked_at"`
+}
+
+// Checker actively pings MongoDB and Redis to work out whether the service can take traffic.
+// MongoDB is required; losing Redis only degrades the service because every read falls back to MongoDB.
+type Checker struct {
+	MongoClient *mongo.Client
+	RedisCache  *cache.RedisCache
+	Timeout     time.Duration
+}
+
+// NewChecker initializes a new Checker with a default per-ping timeout.
+func NewChecker(mongoClient *mongo.Client, redisCache *cache.RedisCache) *Checker {
+	return &Checker{
+		MongoClient: mongoClient,
+		RedisCache:  redisCache,
+		Timeout:     2 * time.Second,
+	}
+}
+
+// Check pings every dependency and returns the aggregated report.
+func (c *Checker) Check(ctx context.Context) Report {
+	mongoStatus := c.ping(ctx, func(ctx context.Context) error {
+		return c.MongoClient.Ping(ctx, nil)
+	})
+	redisStatus := c.ping(ctx, func(ctx context.Context) error {
+		return c.RedisCache.Client.Ping(ctx).Err()
+	})
+
+	overall := StatusUp
+	if mongoStatus.Status == StatusDown {
+		overall = StatusDown
+	} else if redisStatus.Status == StatusDown {
+		overall = StatusDegraded
+	}
+
+	return Report{
+		Status: overall,
+		Dependencies: map[string]DependencyStatus{
+			"mongodb": mongoStatus,
+			"redis":   redisStatus,
+		},
+		CheckedAt: time.Now(),
+	}
+}
+
+func (c *Checker) ping(ctx context.Context, fn func(context.Context) error) DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(ctx)
+	result := DependencyStatus{
+		Status:    StatusUp,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Watch re-runs the check every interval and mirrors the result into the gRPC health server
+// for the overall service ("") and each of the given service names, until ctx is cancelled.
+func (c *Checker) Watch(ctx context.Context, server *health.Server, interval time.Duration, services ...string) {
+	update := func() {
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if c.Check(ctx).Status == StatusDown {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		server.SetServingStatus("", servingStatus)
+		for _, service := range services {
+			server.SetServingStatus(service, servingStatus)
+		}
+	}
+
+	update()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			update()
+		}
+	}
+}
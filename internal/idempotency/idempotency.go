@@ -0,0 +1,194 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"multiplayer-webservice/internal/auth"
+	"multiplayer-webservice/internal/cache"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key carrying the idempotency key.
+	MetadataKey = "idempotency-key"
+
+	// ReplayedMetadataKey marks responses served from the store.
+	ReplayedMetadataKey = "idempotent-replayed"
+
+	maxKeyLength = 255
+)
+
+var (
+	errInProgress = errors.New("a request with this idempotency key is still in progress")
+	errKeyReused  = errors.New("idempotency key was already used for a different request")
+)
+
+// record is what the store keeps per key: a pending marker while the first request runs, then its response.
+type record struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	// Type names the protobuf message of a stored response
+	Type string `json:"type,omitempty"`
+	Body []byte `json:"body,omitempty"`
+}
+
+// Store remembers the successful response of every request sent with an idempotency key for a
+// window, so a client retrying after a network error gets the original result instead of running
+// the request again. Keys are scoped to the caller identity and the method. When Redis is
+// unavailable requests run as if they carried no key.
+type Store struct {
+	redisCache *cache.RedisCache
+	window     time.Duration
+}
+
+// NewStore initializes a Store keeping responses in Redis for window.
+func NewStore(redisCache *cache.RedisCache, window time.Duration) *Store {
+	return &Store{redisCache: redisCache, window: window}
+}
+
+func storeKey(ctx context.Context, scope, key string) string {
+	return "idempotency:" + auth.Identity(ctx) + ":" + scope + ":" + key
+}
+
+func fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// begin claims key for a new request. It returns the stored record when the key was used before,
+// or nil when the caller should run the request and then call finish or abandon.
+func (s *Store) begin(ctx context.Context, key, fingerprint string) (*record, error) {
+	pending, err := json.Marshal(record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := s.redisCache.Client.SetNX(ctx, key, pending, s.window).Result()
+	if err != nil {
+		return nil, err
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	raw, err := s.redisCache.Client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		// Expired or abandoned in between; run without a claim rather than fail the request
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored record
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil, err
+	}
+	if stored.Fingerprint != fingerprint {
+		return nil, errKeyReused
+	}
+	if !stored.Done {
+		return nil, errInProgress
+	}
+	return &stored, nil
+}
+
+// finish stores the response of a claimed request. It runs even if the client has gone away, since
+// that is exactly the case a retry will need the response for.
+func (s *Store) finish(ctx context.Context, key string, rec record) {
+	rec.Done = true
+	data, err := json.Marshal(rec)
+	if err == nil {
+		err = s.redisCache.Set(context.WithoutCancel(ctx), key, string(data), s.window)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to store idempotent response", "key", key, "error", err)
+	}
+}
+
+// abandon releases a claimed key after a failed request so that a retry runs it again.
+func (s *Store) abandon(ctx context.Context, key string) {
+	s.redisCache.Delete(context.WithoutCancel(ctx), key)
+}
+
+func keyFromMetadata(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(MetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// UnaryServerInterceptor serves repeated unary calls carrying the idempotency-key metadata from the
+// store. A nil Store disables the interceptor.
+func (s *Store) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := keyFromMetadata(ctx)
+		msg, ok := req.(proto.Message)
+		if s == nil || key == "" || !ok {
+			return handler(ctx, req)
+		}
+		if len(key) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", MetadataKey, maxKeyLength)
+		}
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		key = storeKey(ctx, info.FullMethod, key)
+		stored, err := s.begin(ctx, key, fingerprint([]byte(info.FullMethod), body))
+		switch {
+		case errors.Is(err, errKeyReused):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errInProgress):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case err != nil:
+			slog.WarnContext(ctx, "Idempotency store unavailable, running request without it", "method", info.FullMethod, "error", err)
+			return handler(ctx, req)
+		case stored != nil:
+			return replayGRPC(ctx, stored)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			s.abandon(ctx, key)
+			return resp, err
+		}
+		if out, ok := resp.(proto.Message); ok {
+			if body, marshalErr := proto.Marshal(out); marshalErr == nil {
+				s.finish(ctx, key, record{Type: string(out.ProtoReflect().Descriptor().FullName()), Body: body})
+			}
+		}
+		return resp, nil
+	}
+}
+
+func replayGRPC(ctx context.Context, stored *record) (interface{}, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(stored.Type))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay stored response: %v", err)
+	}
+	resp := messageType.New().Interface()
+	if err := proto.Unmarshal(stored.Body, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay stored response: %v", err)
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(ReplayedMetadataKey, "true"))
+	return resp, nil
+}
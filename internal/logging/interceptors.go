@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDFromMetadata reuses the caller's x-request-id when present, otherwise generates a new one.
+func requestIDFromMetadata(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return NewRequestID()
+}
+
+// UnaryServerInterceptor tags the call context with a request ID, echoes it back in the
+// response header and logs the outcome of every unary RPC.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := requestIDFromMetadata(ctx)
+		ctx = WithRequestID(ctx, requestID)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestID := requestIDFromMetadata(ss.Context())
+		ctx := WithRequestID(ss.Context(), requestID)
+		ss.SetHeader(metadata.Pairs(RequestIDHeader, requestID))
+
+		start := time.Now()
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		logCall(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	slog.Log(ctx, level, "gRPC call finished",
+		"method", method,
+		"code", code.String(),
+		"duration_ms", time.Since(start).Milliseconds(),
+	)
+}
+
+// GinMiddleware is the HTTP equivalent of UnaryServerInterceptor: it reads or generates the
+// X-Request-ID header, stores it on the request context and logs every request.
+func GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = NewRequestID()
+		}
+		ctx := WithRequestID(c.Request.Context(), requestID)
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(RequestIDHeader, requestID)
+
+		start := time.Now()
+		c.Next()
+
+		slog.InfoContext(ctx, "HTTP request finished",
+			"method", c.Request.Method,
+			"path", c.FullPath(),
+			"status", c.Writer.Status(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		)
+	}
+}
+
+// OutgoingContext copies the request ID from ctx into outgoing gRPC metadata so that
+// calls made on behalf of an HTTP request keep the same correlation ID.
+func OutgoingContext(ctx context.Context) context.Context {
+	if requestID := RequestID(ctx); requestID != "" {
+		return metadata.AppendToOutgoingContext(ctx, RequestIDHeader, requestID)
+	}
+	return ctx
+}
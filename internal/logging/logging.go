@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type contextKey struct{}
+
+// RequestIDKey is the attribute name used for the correlation ID on every log line.
+const RequestIDKey = "request_id"
+
+// RequestIDHeader is the gRPC metadata key and HTTP header carrying the correlation ID.
+const RequestIDHeader = "x-request-id"
+
+var level = new(slog.LevelVar)
+
+// Setup installs a JSON slog handler as the process-wide default logger.
+// Every record logged with a context carrying a request ID is tagged with it.
+func Setup(levelName string) error {
+	if err := SetLevel(levelName); err != nil {
+		return err
+	}
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(&contextHandler{Handler: handler}))
+	return nil
+}
+
+// SetLevel changes the minimum level of the default logger at runtime. An empty name means info.
+func SetLevel(levelName string) error {
+	parsed, err := ParseLevel(levelName)
+	if err != nil {
+		return err
+	}
+	level.Set(parsed)
+	return nil
+}
+
+// ParseLevel converts debug, info, warn or error (case-insensitive) into a slog.Level.
+func ParseLevel(levelName string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(levelName)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("unknown log level %q", levelName)
+}
+
+// WithRequestID returns a copy of ctx carrying the given correlation ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// RequestID returns the correlation ID stored in ctx, or an empty string.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
+
+// NewRequestID generates a random 16-byte hex correlation ID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// contextHandler adds the request ID found in the record's context to every log line.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if requestID := RequestID(ctx); requestID != "" {
+		r.AddAttrs(slog.String(RequestIDKey, requestID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
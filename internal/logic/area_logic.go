@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AreaStats totals the modes of an area and of every area below it in the region hierarchy.
+type AreaStats struct {
+	AreaCode    string `json:"area_code"`
+	Modes       int32  `json:"modes"`
+	ActiveUsers int32  `json:"active_users"`
+	Spectators  int32  `json:"spectators"`
+}
+
+// areaHierarchy is the parent/child structure of the region catalog. Area codes that are not in
+// the catalog have neither parent nor children, so they behave like the flat codes used before.
+type areaHierarchy struct {
+	parents  map[string]string
+	children map[string][]string
+}
+
+func newAreaHierarchy(regions []Region) areaHierarchy {
+	h := areaHierarchy{parents: make(map[string]string, len(regions)), children: make(map[string][]string)}
+	for _, region := range regions {
+		if region.Parent == "" {
+			continue
+		}
+		h.parents[region.AreaCode] = region.Parent
+		h.children[region.Parent] = append(h.children[region.Parent], region.AreaCode)
+	}
+	return h
+}
+
+// loadRegions reads the region catalog without going through the cache.
+func loadRegions(ctx context.Context, collection *mongo.Collection) ([]Region, error) {
+	cursor, err := regionsCollection(collection).Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "area_code", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query regions: %w", err)
+	}
+	var regions []Region
+	if err := cursor.All(ctx, &regions); err != nil {
+		return nil, fmt.Errorf("failed to decode regions: %w", err)
+	}
+	return regions, nil
+}
+
+// withAncestors returns area followed by its parent, grandparent and so on up to a top-level region.
+func (h areaHierarchy) withAncestors(area string) []string {
+	areas := []string{area}
+	seen := map[string]bool{area: true}
+	for parent := h.parents[area]; parent != "" && !seen[parent]; parent = h.parents[parent] {
+		seen[parent] = true
+		areas = append(areas, parent)
+	}
+	return areas
+}
+
+// subtree returns area followed by every area below it.
+func (h areaHierarchy) subtree(area string) []string {
+	areas := []string{area}
+	seen := map[string]bool{area: true}
+	for i := 0; i < len(areas); i++ {
+		for _, child := range h.children[areas[i]] {
+			if !seen[child] {
+				seen[child] = true
+				areas = append(areas, child)
+			}
+		}
+	}
+	return areas
+}
+
+// areaWithAncestors looks up the areas a count recorded for area rolls up into. When the catalog
+// cannot be read the count is only recorded for area itself.
+func areaWithAncestors(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, area string) []string {
+	regions, err := ListRegionsLogic(ctx, collection, redisCache)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load regions, not rolling up", "area_code", area, "error", err)
+		return []string{area}
+	}
+	return newAreaHierarchy(regions).withAncestors(area)
+}
+
+// invalidateAreaStats drops the cached totals of areas. Callers pass an area together with its
+// ancestors, since a change in an area also changes the totals of every region above it.
+func invalidateAreaStats(ctx context.Context, redisCache *cache.RedisCache, areas ...string) {
+	for _, area := range areas {
+		redisCache.Delete(ctx, "area_stats:"+area)
+	}
+}
+
+// GetAreaStatsLogic returns the totals of an area at any level of the region hierarchy. Every level
+// is cached on its own, so the totals of a region are summed from the cached totals of its children.
+func GetAreaStatsLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, areaCode string) (*AreaStats, error) {
+	regions, err := ListRegionsLogic(ctx, collection, redisCache)
+	if err != nil {
+		return nil, err
+	}
+	return areaStats(ctx, collection, redisCache, newAreaHierarchy(regions), areaCode, map[string]bool{})
+}
+
+func areaStats(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, h areaHierarchy, areaCode string, visited map[string]bool) (*AreaStats, error) {
+	cacheKey := "area_stats:" + areaCode
+	if cachedData, err := redisCache.Get(ctx, cacheKey); err == nil {
+		var stats AreaStats
+		if jsonErr := json.Unmarshal([]byte(cachedData), &stats); jsonErr == nil {
+			return &stats, nil
+		}
+	}
+	visited[areaCode] = true
+
+	// Modes placed directly in this area
+	stats := &AreaStats{AreaCode: areaCode}
+	opts := options.Find().SetProjection(bson.M{"active_users": 1, "spectator_count": 1})
+	cursor, err := collection.Find(ctx, bson.M{"area_code": areaCode}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var modes []ModeUsage
+	if err := cursor.All(ctx, &modes); err != nil {
+		return nil, err
+	}
+	for _, mode := range modes {
+		stats.Modes++
+		stats.ActiveUsers += int32(mode.ActiveUsers)
+		stats.Spectators += int32(mode.SpectatorCount)
+	}
+
+	for _, child := range h.children[areaCode] {
+		if visited[child] {
+			continue
+		}
+		childStats, err := areaStats(ctx, collection, redisCache, h, child, visited)
+		if err != nil {
+			return nil, err
+		}
+		stats.Modes += childStats.Modes
+		stats.ActiveUsers += childStats.ActiveUsers
+		stats.Spectators += childStats.Spectators
+	}
+
+	if jsonData, err := json.Marshal(stats); err == nil {
+		redisCache.Set(ctx, cacheKey, string(jsonData), cacheTTL())
+	}
+	return stats, nil
+}
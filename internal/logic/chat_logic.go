@@ -0,0 +1,248 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"multiplayer-webservice/internal/cache"
+
+	"github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ChatMessage is one message of a mode's chat, as published to every replica and kept in the history.
+type ChatMessage struct {
+	ID       string    `json:"id"`
+	ModeName string    `json:"mode_name"`
+	PlayerID string    `json:"player_id"`
+	Text     string    `json:"text"`
+	SentAt   time.Time `json:"sent_at"`
+}
+
+// ChatFilter inspects a message before it is sent. It may rewrite msg.Text, or return an error
+// wrapping ErrMessageRejected to drop the message.
+type ChatFilter interface {
+	FilterMessage(ctx context.Context, msg *ChatMessage) error
+}
+
+// MuteChecker reports whether a player may currently not send chat messages in a mode.
+type MuteChecker interface {
+	IsMuted(ctx context.Context, modeName, playerId string) (bool, error)
+}
+
+// ChatHooks are applied to every message sent through SendChatMessageLogic. Zero hooks let every
+// message from a member of the mode through unchanged.
+type ChatHooks struct {
+	Filters []ChatFilter
+	Mutes   MuteChecker
+}
+
+func chatChannel(modeName string) string {
+	return "chat:" + modeName
+}
+
+func chatHistoryKey(modeName string) string {
+	return "chat_history:" + modeName
+}
+
+// checkPlayerInMode returns ErrModeNotFound or ErrPlayerNotInMode unless the player is in the mode's players list.
+func checkPlayerInMode(ctx context.Context, collection *mongo.Collection, modeName, playerId string) error {
+	var mode ModeUsage
+	opts := options.FindOne().SetProjection(bson.M{"players": bson.M{"$elemMatch": bson.M{"$eq": playerId}}})
+	err := collection.FindOne(ctx, bson.M{"mode_name": modeName}, opts).Decode(&mode)
+	if err == mongo.ErrNoDocuments {
+		return ErrModeNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if len(mode.Players) == 0 {
+		return ErrPlayerNotInMode
+	}
+	return nil
+}
+
+// ChatSubscription delivers the messages of one mode's chat to one participant.
+type ChatSubscription struct {
+	// History holds the most recent messages, oldest first, as they were when the subscription opened.
+	History  []ChatMessage
+	pubsub   *redis.PubSub
+	messages chan ChatMessage
+}
+
+// Messages returns the channel new messages are delivered on. It is closed when the subscription is.
+func (s *ChatSubscription) Messages() <-chan ChatMessage {
+	return s.messages
+}
+
+// Close stops delivery and releases the Redis subscription.
+func (s *ChatSubscription) Close() error {
+	return s.pubsub.Close()
+}
+
+// SubscribeChatLogic opens a player's view of a mode's chat: the recent history plus every message
+// sent afterwards on any replica. Only players in the mode may subscribe.
+func SubscribeChatLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, playerId string, historySize int) (*ChatSubscription, error) {
+	if err := checkPlayerInMode(ctx, collection, modeName, playerId); err != nil {
+		return nil, err
+	}
+
+	// Subscribe before reading the history so no message falls between the two
+	pubsub := redisCache.Client.Subscribe(ctx, chatChannel(modeName))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to chat: %w", err)
+	}
+
+	sub := &ChatSubscription{pubsub: pubsub, messages: make(chan ChatMessage)}
+	if historySize > 0 {
+		raw, err := redisCache.Client.LRange(ctx, chatHistoryKey(modeName), 0, int64(historySize)-1).Result()
+		if err != nil {
+			pubsub.Close()
+			return nil, fmt.Errorf("failed to read chat history: %w", err)
+		}
+		// The history list is newest first
+		for i := len(raw) - 1; i >= 0; i-- {
+			var msg ChatMessage
+			if err := json.Unmarshal([]byte(raw[i]), &msg); err == nil {
+				sub.History = append(sub.History, msg)
+			}
+		}
+	}
+
+	go func() {
+		defer close(sub.messages)
+		for published := range pubsub.Channel() {
+			var msg ChatMessage
+			if err := json.Unmarshal([]byte(published.Payload), &msg); err != nil {
+				slog.WarnContext(ctx, "Dropping malformed chat message", "mode_name", modeName, "error", err)
+				continue
+			}
+			select {
+			case sub.messages <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// SendChatMessageLogic runs a message through the hooks, appends it to the mode's bounded history
+// and publishes it to every subscriber. The sender must still be a player in the mode.
+func SendChatMessageLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, hooks ChatHooks, modeName, playerId, text string, historySize int) (*ChatMessage, error) {
+	if err := checkPlayerInMode(ctx, collection, modeName, playerId); err != nil {
+		return nil, err
+	}
+	if hooks.Mutes != nil {
+		muted, err := hooks.Mutes.IsMuted(ctx, modeName, playerId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check mutes: %w", err)
+		}
+		if muted {
+			return nil, ErrPlayerMuted
+		}
+	}
+
+	msg := &ChatMessage{
+		ID:       primitive.NewObjectID().Hex(),
+		ModeName: modeName,
+		PlayerID: playerId,
+		Text:     text,
+		SentAt:   time.Now(),
+	}
+	for _, filter := range hooks.Filters {
+		if err := filter.FilterMessage(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	pipe := redisCache.Client.TxPipeline()
+	if historySize > 0 {
+		pipe.LPush(ctx, chatHistoryKey(modeName), payload)
+		pipe.LTrim(ctx, chatHistoryKey(modeName), 0, int64(historySize)-1)
+	}
+	pipe.Publish(ctx, chatChannel(modeName), payload)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to send chat message: %w", err)
+	}
+	return msg, nil
+}
+
+// WordFilter masks blocked words with asterisks. Words are matched case-insensitively and only as
+// whole words, so blocking "ass" leaves "class" alone.
+type WordFilter struct {
+	blocked map[string]bool
+}
+
+// NewWordFilter initializes a WordFilter blocking the given words.
+func NewWordFilter(words []string) *WordFilter {
+	blocked := make(map[string]bool, len(words))
+	for _, word := range words {
+		blocked[strings.ToLower(word)] = true
+	}
+	return &WordFilter{blocked: blocked}
+}
+
+// FilterMessage implements ChatFilter.
+func (f *WordFilter) FilterMessage(ctx context.Context, msg *ChatMessage) error {
+	if len(f.blocked) == 0 {
+		return nil
+	}
+	runes := []rune(msg.Text)
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		inWord := i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]))
+		if inWord && start < 0 {
+			start = i
+		}
+		if !inWord && start >= 0 {
+			if f.blocked[strings.ToLower(string(runes[start:i]))] {
+				for j := start; j < i; j++ {
+					runes[j] = '*'
+				}
+			}
+			start = -1
+		}
+	}
+	msg.Text = string(runes)
+	return nil
+}
+
+// SanctionMuteChecker treats a player as muted while a mute sanction for the mode, or for every
+// mode, is in force.
+type SanctionMuteChecker struct {
+	collection *mongo.Collection
+}
+
+// NewSanctionMuteChecker initializes a SanctionMuteChecker reading the sanctions stored next to the modes collection.
+func NewSanctionMuteChecker(collection *mongo.Collection) *SanctionMuteChecker {
+	return &SanctionMuteChecker{collection: collection}
+}
+
+// IsMuted implements MuteChecker.
+func (m *SanctionMuteChecker) IsMuted(ctx context.Context, modeName, playerId string) (bool, error) {
+	filter := bson.M{
+		"type":      SanctionMute,
+		"player_id": playerId,
+		"mode_name": bson.M{"$in": bson.A{modeName, ""}},
+		"$or":       notExpired(time.Now()),
+	}
+	count, err := sanctionsCollection(m.collection).CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
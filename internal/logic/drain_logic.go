@@ -0,0 +1,103 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// ModeEventsChannel is the Redis pub/sub channel mode lifecycle events are published on.
+	ModeEventsChannel = "mode_events"
+
+	// ModeEventDrained is published when the last player leaves a draining mode.
+	ModeEventDrained = "mode_drained"
+)
+
+// ModeDrain marks a mode as draining: players already in it may stay, new joins are rejected
+// and clients are told to retry after RetryAfter.
+type ModeDrain struct {
+	Since      time.Time     `bson:"since"`
+	RetryAfter time.Duration `bson:"retry_after"`
+	Reason     string        `bson:"reason,omitempty"`
+}
+
+// ModeDrainingError is returned by JoinModeLogic for a draining mode. It matches ErrModeDraining.
+type ModeDrainingError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ModeDrainingError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", ErrModeDraining, e.RetryAfter)
+}
+
+func (e *ModeDrainingError) Is(target error) bool {
+	return target == ErrModeDraining
+}
+
+// ModeEvent is the JSON payload published on ModeEventsChannel.
+type ModeEvent struct {
+	Type     string    `json:"type"`
+	ModeName string    `json:"mode_name"`
+	At       time.Time `json:"at"`
+}
+
+// publishModeEvent notifies subscribers of ModeEventsChannel. Failures are only logged; the event
+// is also written to the log so it is never lost entirely.
+func publishModeEvent(ctx context.Context, redisCache *cache.RedisCache, event ModeEvent) {
+	slog.InfoContext(ctx, "Mode event", "type", event.Type, "mode_name", event.ModeName)
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	if err := redisCache.Client.Publish(ctx, ModeEventsChannel, payload).Err(); err != nil {
+		slog.WarnContext(ctx, "Failed to publish mode event", "type", event.Type, "mode_name", event.ModeName, "error", err)
+	}
+}
+
+// notifyIfDrained publishes ModeEventDrained when mode, as it is after a player left, is draining and empty.
+func notifyIfDrained(ctx context.Context, redisCache *cache.RedisCache, mode ModeUsage) {
+	if mode.Drain != nil && mode.ActiveUsers <= 0 {
+		publishModeEvent(ctx, redisCache, ModeEvent{Type: ModeEventDrained, ModeName: mode.ModeName, At: time.Now()})
+	}
+}
+
+// DrainModeLogic stops new joins to a mode while letting current players finish. It returns the
+// number of players still in the mode; when it is already empty the drained event is sent at once.
+func DrainModeLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName string, retryAfter time.Duration, reason string) (int, error) {
+	drain := ModeDrain{Since: time.Now(), RetryAfter: retryAfter, Reason: reason}
+	var mode ModeUsage
+	opts := options.FindOneAndUpdate().
+		SetProjection(bson.M{"mode_name": 1, "active_users": 1, "drain": 1}).
+		SetReturnDocument(options.After)
+	err := collection.FindOneAndUpdate(ctx, bson.M{"mode_name": modeName}, bson.M{"$set": bson.M{"drain": drain}}, opts).Decode(&mode)
+	if err == mongo.ErrNoDocuments {
+		return 0, ErrModeNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	notifyIfDrained(ctx, redisCache, mode)
+	return mode.ActiveUsers, nil
+}
+
+// ResumeModeLogic lifts a drain so the mode accepts joins again.
+func ResumeModeLogic(ctx context.Context, collection *mongo.Collection, modeName string) error {
+	result, err := collection.UpdateOne(ctx, bson.M{"mode_name": modeName}, bson.M{"$unset": bson.M{"drain": ""}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrModeNotFound
+	}
+	return nil
+}
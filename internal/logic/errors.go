@@ -0,0 +1,64 @@
+package logic
+
+import "errors"
+
+var (
+	// ErrModeNotFound is returned when no mode document matches the requested mode name.
+	ErrModeNotFound = errors.New("mode not found")
+	// ErrModeFull is returned when a join would exceed the mode's configured capacity.
+	ErrModeFull = errors.New("mode is full")
+	// ErrSpectatorsFull is returned when a mode already has as many spectators as its spectator capacity.
+	ErrSpectatorsFull = errors.New("mode has no room for more spectators")
+	// ErrModeUnavailable is returned when a mode is joined outside its availability window.
+	ErrModeUnavailable = errors.New("mode is not available at this time")
+	// ErrModeDraining is matched by the *ModeDrainingError returned when a draining mode is joined.
+	ErrModeDraining = errors.New("mode is draining")
+	// ErrPlayerBanned is wrapped by the error returned when a banned player tries to join a mode.
+	ErrPlayerBanned = errors.New("player is banned")
+	// ErrPlayerNotInMode is returned when a player is kicked from a mode they are not in.
+	ErrPlayerNotInMode = errors.New("player is not in this mode")
+	// ErrGameStateConflict is returned when a game state update loses a race with another update.
+	ErrGameStateConflict = errors.New("game state version conflict")
+	// ErrGameStateNotRecorded is returned when the game state history has no entry at or before the requested time.
+	ErrGameStateNotRecorded = errors.New("no game state recorded at that time")
+	// ErrServerNotFound is returned when a game server is not in the registry.
+	ErrServerNotFound = errors.New("game server not registered")
+	// ErrAllocationNotFound is returned when a game server holds no allocation with the given ID.
+	ErrAllocationNotFound = errors.New("allocation not found")
+	// ErrNoServerAvailable is returned when no healthy game server has room for a match.
+	ErrNoServerAvailable = errors.New("no game server available")
+	// ErrRegionNotFound is returned when an area code is not in the region catalog.
+	ErrRegionNotFound = errors.New("region not found")
+	// ErrInvalidRegion is returned when a change would break the region hierarchy.
+	ErrInvalidRegion = errors.New("invalid region")
+	// ErrNoLatencies is returned when an area is recommended for a player who has not reported latencies.
+	ErrNoLatencies = errors.New("player has not reported latencies to any known area")
+	// ErrReservationInvalid is returned when a join presents a reservation token that is unknown, expired or issued to another player.
+	ErrReservationInvalid = errors.New("seat reservation is invalid or expired")
+	// ErrPlayerMuted is returned when a muted player sends a chat message.
+	ErrPlayerMuted = errors.New("player is muted")
+	// ErrMessageRejected is wrapped by chat filters that drop a message.
+	ErrMessageRejected = errors.New("chat message rejected")
+	// ErrWebhookNotFound is returned when no webhook subscription has the requested ID.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrInvalidWebhook is returned when a webhook subscription has a bad URL or an unknown event type.
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrWebhookDeliveryNotFound is returned when no webhook delivery has the requested ID.
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrWebhookDeliveryNotDead is returned when a delivery that is not in the dead-letter list is retried.
+	ErrWebhookDeliveryNotDead = errors.New("webhook delivery is not in the dead-letter list")
+	// ErrInvalidSchedule is returned when a mode schedule cannot be evaluated.
+	ErrInvalidSchedule = errors.New("invalid mode schedule")
+	// ErrPlayerNotRanked is returned when a player has no score on the requested leaderboard.
+	ErrPlayerNotRanked = errors.New("player has no score on this leaderboard")
+	// ErrSeasonNotFound is returned when no season with the requested ID covers the mode.
+	ErrSeasonNotFound = errors.New("season not found")
+	// ErrNoActiveSeason is returned when no season covers the mode at the current time.
+	ErrNoActiveSeason = errors.New("no active season for this mode")
+	// ErrSeasonClosed is returned when results are reported for a season that is not running.
+	ErrSeasonClosed = errors.New("season is not running")
+	// ErrSeasonExists is returned when a season is created with an ID that is already taken.
+	ErrSeasonExists = errors.New("season already exists")
+	// ErrSeasonOverlap is returned when a new season overlaps another season covering the same mode.
+	ErrSeasonOverlap = errors.New("season overlaps an existing season")
+)
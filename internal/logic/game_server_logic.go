@@ -0,0 +1,192 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	gameServersCollectionName = "game_servers"
+
+	// maxAllocationAttempts bounds how often AllocateServerLogic re-reads the candidates when other
+	// allocations keep taking the free slots it picked.
+	maxAllocationAttempts = 3
+)
+
+// GameServer is a dedicated server that hosts matches. Capacity is the number of matches it can run
+// at once; Allocated counts the reservations handed out by AllocateServerLogic and not yet released.
+// A server that can host any mode has no Modes.
+type GameServer struct {
+	ServerID      string             `bson:"server_id"`
+	Address       string             `bson:"address"`
+	AreaCode      string             `bson:"area_code"`
+	Modes         []string           `bson:"modes"`
+	Capacity      int                `bson:"capacity"`
+	Allocated     int                `bson:"allocated"`
+	Allocations   []ServerAllocation `bson:"allocations"`
+	RegisteredAt  time.Time          `bson:"registered_at"`
+	LastHeartbeat time.Time          `bson:"last_heartbeat"`
+}
+
+// ServerAllocation reserves one slot of a game server for a match of a mode.
+type ServerAllocation struct {
+	AllocationID string    `bson:"allocation_id"`
+	ModeName     string    `bson:"mode_name"`
+	AllocatedAt  time.Time `bson:"allocated_at"`
+	// ServerID, Address and AreaCode describe the server; they are filled in when returned and not stored.
+	ServerID string `bson:"-"`
+	Address  string `bson:"-"`
+	AreaCode string `bson:"-"`
+}
+
+func gameServersCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, gameServersCollectionName)
+}
+
+// load is the fraction of the server's capacity that is reserved.
+func (s GameServer) load() float64 {
+	return float64(s.Allocated) / float64(s.Capacity)
+}
+
+// RegisterServerLogic adds a game server to the registry, or updates its details when it registers
+// again; reservations it already holds are kept. The registration counts as a heartbeat.
+func RegisterServerLogic(ctx context.Context, collection *mongo.Collection, server GameServer, now time.Time) error {
+	filter := bson.M{"server_id": server.ServerID}
+	update := bson.M{
+		"$set": bson.M{
+			"address":        server.Address,
+			"area_code":      server.AreaCode,
+			"modes":          server.Modes,
+			"capacity":       server.Capacity,
+			"last_heartbeat": now,
+		},
+		"$setOnInsert": bson.M{
+			"allocated":     0,
+			"allocations":   bson.A{},
+			"registered_at": now,
+		},
+	}
+	if _, err := gameServersCollection(collection).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to register server: %w", err)
+	}
+	return nil
+}
+
+// ServerHeartbeatLogic keeps a game server healthy. It returns ErrServerNotFound when the server is
+// not registered, for example after it was evicted, so the server knows to register again.
+func ServerHeartbeatLogic(ctx context.Context, collection *mongo.Collection, serverId string, now time.Time) error {
+	result, err := gameServersCollection(collection).UpdateOne(ctx, bson.M{"server_id": serverId}, bson.M{"$set": bson.M{"last_heartbeat": now}})
+	if err != nil {
+		return fmt.Errorf("failed to record heartbeat: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrServerNotFound
+	}
+	return nil
+}
+
+// DeregisterServerLogic removes a game server and its reservations. Deregistering an unknown server is a no-op.
+func DeregisterServerLogic(ctx context.Context, collection *mongo.Collection, serverId string) error {
+	if _, err := gameServersCollection(collection).DeleteOne(ctx, bson.M{"server_id": serverId}); err != nil {
+		return fmt.Errorf("failed to deregister server: %w", err)
+	}
+	return nil
+}
+
+// AllocateServerLogic reserves a slot on the least-loaded healthy server that can host the mode in
+// the area; an empty areaCode accepts any area. A server is healthy while its last heartbeat is
+// younger than heartbeatTTL. It returns ErrNoServerAvailable when every matching server is full.
+func AllocateServerLogic(ctx context.Context, collection *mongo.Collection, modeName, areaCode string, heartbeatTTL time.Duration, now time.Time) (*ServerAllocation, error) {
+	exists, err := collection.CountDocuments(ctx, bson.M{"mode_name": modeName}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		return nil, ErrModeNotFound
+	}
+
+	cutoff := now.Add(-heartbeatTTL)
+	filter := bson.M{
+		"last_heartbeat": bson.M{"$gte": cutoff},
+		"$expr":          bson.M{"$lt": bson.A{"$allocated", "$capacity"}},
+		"$or":            bson.A{bson.M{"modes": modeName}, bson.M{"modes": bson.M{"$in": bson.A{nil, bson.A{}}}}},
+	}
+	if areaCode != "" {
+		filter["area_code"] = areaCode
+	}
+	servers := gameServersCollection(collection)
+	opts := options.Find().SetProjection(bson.M{"allocations": 0})
+
+	for attempt := 0; attempt < maxAllocationAttempts; attempt++ {
+		cursor, err := servers.Find(ctx, filter, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find servers: %w", err)
+		}
+		var candidates []GameServer
+		if err := cursor.All(ctx, &candidates); err != nil {
+			return nil, fmt.Errorf("failed to decode servers: %w", err)
+		}
+		if len(candidates) == 0 {
+			return nil, ErrNoServerAvailable
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].load() != candidates[j].load() {
+				return candidates[i].load() < candidates[j].load()
+			}
+			return candidates[i].ServerID < candidates[j].ServerID
+		})
+
+		allocation := ServerAllocation{AllocationID: primitive.NewObjectID().Hex(), ModeName: modeName, AllocatedAt: now}
+		for _, server := range candidates {
+			// Only reserve if nobody else took a slot since the server was read
+			claim := bson.M{"server_id": server.ServerID, "allocated": server.Allocated, "last_heartbeat": bson.M{"$gte": cutoff}}
+			update := bson.M{"$inc": bson.M{"allocated": 1}, "$push": bson.M{"allocations": allocation}}
+			result, err := servers.UpdateOne(ctx, claim, update)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reserve server: %w", err)
+			}
+			if result.ModifiedCount == 1 {
+				allocation.ServerID = server.ServerID
+				allocation.Address = server.Address
+				allocation.AreaCode = server.AreaCode
+				return &allocation, nil
+			}
+		}
+	}
+	return nil, ErrNoServerAvailable
+}
+
+// ReleaseServerLogic frees the slot held by an allocation once its match is over. It returns
+// ErrAllocationNotFound when the server holds no such allocation.
+func ReleaseServerLogic(ctx context.Context, collection *mongo.Collection, serverId, allocationId string) error {
+	filter := bson.M{"server_id": serverId, "allocations.allocation_id": allocationId}
+	update := bson.M{
+		"$inc":  bson.M{"allocated": -1},
+		"$pull": bson.M{"allocations": bson.M{"allocation_id": allocationId}},
+	}
+	result, err := gameServersCollection(collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to release allocation: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrAllocationNotFound
+	}
+	return nil
+}
+
+// EvictStaleServersLogic removes the servers that have not sent a heartbeat within heartbeatTTL and
+// returns how many were removed.
+func EvictStaleServersLogic(ctx context.Context, collection *mongo.Collection, heartbeatTTL time.Duration, now time.Time) (int64, error) {
+	result, err := gameServersCollection(collection).DeleteMany(ctx, bson.M{"last_heartbeat": bson.M{"$lt": now.Add(-heartbeatTTL)}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to evict stale servers: %w", err)
+	}
+	return result.DeletedCount, nil
+}
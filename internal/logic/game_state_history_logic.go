@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const gameStateHistoryCollectionName = "game_state_history"
+
+// gameStateRecord is one accepted game state update as stored in the history. ModeID is the _id
+// of the mode document, so a mode that is deleted and created again under the same name starts a
+// new sequence of versions instead of colliding with the old one.
+type gameStateRecord struct {
+	ModeID    bson.RawValue `bson:"mode_id"`
+	ModeName  string        `bson:"mode_name"`
+	Version   int64         `bson:"version"`
+	Status    string        `bson:"game_state"`
+	State     bson.M        `bson:"state"`
+	UpdatedBy string        `bson:"updated_by"`
+	UpdatedAt time.Time     `bson:"updated_at"`
+}
+
+func (r gameStateRecord) gameState() GameState {
+	return GameState{
+		ModeName:  r.ModeName,
+		Status:    r.Status,
+		Data:      plainDocument(r.State),
+		Version:   r.Version,
+		UpdatedAt: r.UpdatedAt,
+		UpdatedBy: r.UpdatedBy,
+	}
+}
+
+func gameStateHistoryCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, gameStateHistoryCollectionName)
+}
+
+// recordGameState appends an accepted update of the mode with _id modeID to the history. It is
+// called inside the update, so the update fails when its history entry cannot be written.
+func recordGameState(ctx context.Context, collection *mongo.Collection, modeID bson.RawValue, state *GameState) error {
+	record := gameStateRecord{
+		ModeID:    modeID,
+		ModeName:  state.ModeName,
+		Version:   state.Version,
+		Status:    state.Status,
+		State:     state.Data,
+		UpdatedBy: state.UpdatedBy,
+		UpdatedAt: state.UpdatedAt,
+	}
+	if _, err := gameStateHistoryCollection(collection).InsertOne(ctx, record); err != nil {
+		return fmt.Errorf("failed to record game state history: %w", err)
+	}
+	return nil
+}
+
+// GetGameStateHistoryLogic returns the recorded states of a mode updated within [from, to], oldest
+// first. A zero from or to leaves that end open.
+func GetGameStateHistoryLogic(ctx context.Context, collection *mongo.Collection, modeName string, from, to time.Time, limit int64) ([]GameState, error) {
+	filter := bson.M{"mode_name": modeName}
+	updatedAt := bson.M{}
+	if !from.IsZero() {
+		updatedAt["$gte"] = from
+	}
+	if !to.IsZero() {
+		updatedAt["$lte"] = to
+	}
+	if len(updatedAt) > 0 {
+		filter["updated_at"] = updatedAt
+	}
+
+	// Versions start again when a mode is recreated, so the timeline is ordered by time first
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: 1}, {Key: "version", Value: 1}}).SetLimit(limit)
+	cursor, err := gameStateHistoryCollection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query game state history: %w", err)
+	}
+	var records []gameStateRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode game state history: %w", err)
+	}
+
+	states := make([]GameState, len(records))
+	for i, record := range records {
+		states[i] = record.gameState()
+	}
+	return states, nil
+}
+
+// GetGameStateAtLogic returns the state a mode was in at the given time, that is the last recorded
+// update at or before it. It returns ErrGameStateNotRecorded when the history starts later.
+func GetGameStateAtLogic(ctx context.Context, collection *mongo.Collection, modeName string, at time.Time) (*GameState, error) {
+	filter := bson.M{"mode_name": modeName, "updated_at": bson.M{"$lte": at}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "version", Value: -1}})
+	var record gameStateRecord
+	err := gameStateHistoryCollection(collection).FindOne(ctx, filter, opts).Decode(&record)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrGameStateNotRecorded
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query game state history: %w", err)
+	}
+	state := record.gameState()
+	return &state, nil
+}
@@ -0,0 +1,207 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxGameStateAttempts bounds how often an unconditional patch is retried when other writers keep
+// moving the version underneath it.
+const maxGameStateAttempts = 5
+
+// GameState is what game servers keep on a mode: a lifecycle status such as "active" or "paused"
+// and a free-form document (scores, map, round, timers). Both share one version that increases by
+// one on every accepted update.
+type GameState struct {
+	ModeName  string
+	Status    string
+	Data      map[string]interface{}
+	Version   int64
+	UpdatedAt time.Time
+	// UpdatedBy is the caller that produced this version, as recorded in the game state history.
+	UpdatedBy string
+}
+
+// GameStatePatch describes one update. Data is merged into the document like a JSON merge patch:
+// nested objects are merged key by key and nil values delete keys. With Replace set, Data replaces
+// the whole document instead. An empty Status leaves the status unchanged.
+type GameStatePatch struct {
+	Status  string
+	Data    map[string]interface{}
+	Replace bool
+	// ExpectedVersion, when set, makes the update fail with ErrGameStateConflict unless the state is
+	// still at that version.
+	ExpectedVersion *int64
+	// UpdatedBy identifies the caller in the game state history.
+	UpdatedBy string
+}
+
+func gameStateFromMode(mode ModeUsage) *GameState {
+	return &GameState{
+		ModeName:  mode.ModeName,
+		Status:    mode.GameState,
+		Data:      plainDocument(mode.State),
+		Version:   mode.StateVersion,
+		UpdatedAt: mode.LastUpdated,
+	}
+}
+
+// plainDocument converts a document decoded by the Mongo driver into plain maps, slices and
+// JSON-compatible scalars.
+func plainDocument(doc bson.M) map[string]interface{} {
+	out := make(map[string]interface{}, len(doc))
+	for key, value := range doc {
+		out[key] = plainValue(value)
+	}
+	return out
+}
+
+func plainValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.M:
+		return plainDocument(v)
+	case map[string]interface{}:
+		return plainDocument(v)
+	case bson.D:
+		return plainDocument(v.Map())
+	case bson.A:
+		return plainSlice(v)
+	case []interface{}:
+		return plainSlice(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case primitive.DateTime:
+		return v.Time().UTC().Format(time.RFC3339Nano)
+	case primitive.ObjectID:
+		return v.Hex()
+	case nil, bool, float64, string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func plainSlice(values []interface{}) []interface{} {
+	out := make([]interface{}, len(values))
+	for i, value := range values {
+		out[i] = plainValue(value)
+	}
+	return out
+}
+
+// mergePatch applies patch to target following JSON merge patch rules and returns the result.
+func mergePatch(target, patch map[string]interface{}) map[string]interface{} {
+	if target == nil {
+		target = make(map[string]interface{}, len(patch))
+	}
+	for key, value := range patch {
+		switch v := value.(type) {
+		case nil:
+			delete(target, key)
+		case map[string]interface{}:
+			existing, _ := target[key].(map[string]interface{})
+			target[key] = mergePatch(existing, v)
+		default:
+			target[key] = v
+		}
+	}
+	return target
+}
+
+// versionFilter matches a mode whose state is at version; documents written before versioning have none.
+func versionFilter(modeName string, version int64) bson.M {
+	if version == 0 {
+		return bson.M{"mode_name": modeName, "state_version": bson.M{"$in": bson.A{0, nil}}}
+	}
+	return bson.M{"mode_name": modeName, "state_version": version}
+}
+
+// GetGameStateLogic returns the current game state of a mode.
+func GetGameStateLogic(ctx context.Context, collection *mongo.Collection, modeName string) (*GameState, error) {
+	var mode ModeUsage
+	opts := options.FindOne().SetProjection(bson.M{"mode_name": 1, "game_state": 1, "state": 1, "state_version": 1, "last_updated": 1})
+	err := collection.FindOne(ctx, bson.M{"mode_name": modeName}, opts).Decode(&mode)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrModeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gameStateFromMode(mode), nil
+}
+
+// PatchGameStateLogic applies patch to a mode's game state and returns the new state. Each update
+// is a compare-and-set on the version, so concurrent writers never overwrite each other: with an
+// ExpectedVersion a lost race is reported as ErrGameStateConflict, without one the patch is
+// re-applied on top of the newer state.
+func PatchGameStateLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName string, patch GameStatePatch) (*GameState, error) {
+	for attempt := 0; attempt < maxGameStateAttempts; attempt++ {
+		current, err := GetGameStateLogic(ctx, collection, modeName)
+		if err != nil {
+			return nil, err
+		}
+		if patch.ExpectedVersion != nil && *patch.ExpectedVersion != current.Version {
+			return nil, fmt.Errorf("%w: expected version %d, current version is %d", ErrGameStateConflict, *patch.ExpectedVersion, current.Version)
+		}
+
+		data := plainDocument(patch.Data)
+		if !patch.Replace {
+			data = mergePatch(current.Data, data)
+		}
+		now := time.Now()
+		set := bson.M{"state": data, "state_version": current.Version + 1, "last_updated": now}
+		if patch.Status != "" {
+			set["game_state"] = patch.Status
+		}
+
+		var updated ModeUsage
+		var state *GameState
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+		err = updateWithOutbox(ctx, collection, func(ctx context.Context) (*OutboxEvent, error) {
+			raw, err := collection.FindOneAndUpdate(ctx, versionFilter(modeName, current.Version), bson.M{"$set": set}, opts).Raw()
+			if err != nil {
+				return nil, err
+			}
+			if err := bson.Unmarshal(raw, &updated); err != nil {
+				return nil, err
+			}
+
+			// The history entry is written in the same transaction: no version is accepted without one
+			state = gameStateFromMode(updated)
+			state.UpdatedBy = patch.UpdatedBy
+			if err := recordGameState(ctx, collection, raw.Lookup("_id"), state); err != nil {
+				return nil, err
+			}
+
+			event := bson.M{"status": updated.GameState, "previous_status": current.Status, "version": updated.StateVersion, "state": data, "updated_by": patch.UpdatedBy}
+			return &OutboxEvent{Type: OutboxEventGameStateChanged, ModeName: modeName, Data: event, At: time.Now()}, nil
+		})
+		if err == mongo.ErrNoDocuments {
+			// Another writer got in first, or the mode was deleted; the next read tells which
+			if patch.ExpectedVersion != nil {
+				return nil, fmt.Errorf("%w: version %d was superseded", ErrGameStateConflict, current.Version)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if jsonData, err := json.Marshal(updated); err == nil {
+			redisCache.Set(ctx, "mode_"+modeName, string(jsonData), cacheTTL())
+		}
+		return state, nil
+	}
+	return nil, fmt.Errorf("%w: gave up after %d attempts", ErrGameStateConflict, maxGameStateAttempts)
+}
@@ -0,0 +1,235 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// HistoryScopeMode and HistoryScopeArea identify what a time-series sample is keyed by.
+	HistoryScopeMode = "mode"
+	HistoryScopeArea = "area"
+
+	resolutionRaw    = "raw"
+	resolutionHourly = "hourly"
+
+	historyCollectionName = "active_users_history"
+)
+
+// ActiveUsersSample is one point of the active-user time series. Raw samples hold a single
+// observation (Count 1); hourly samples are roll-ups of every raw sample in that hour.
+type ActiveUsersSample struct {
+	Scope      string    `bson:"scope"`
+	Key        string    `bson:"key"`
+	Resolution string    `bson:"resolution"`
+	Time       time.Time `bson:"time"`
+	Sum        int64     `bson:"sum"`
+	Count      int64     `bson:"count"`
+	Peak       int       `bson:"peak"`
+	PeakTime   time.Time `bson:"peak_time"`
+}
+
+// HistoryPoint is one bucket returned by GetActiveUsersHistoryLogic.
+type HistoryPoint struct {
+	Time    time.Time
+	Average float64
+	Peak    int
+}
+
+// ActiveUsersHistory is the bucketed time series plus the peak over the whole window.
+type ActiveUsersHistory struct {
+	Points   []HistoryPoint
+	Peak     int
+	PeakTime time.Time
+}
+
+func historyCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, historyCollectionName)
+}
+
+// SampleActiveUsersLogic snapshots the current active users of every mode and every area code,
+// rolled up through the region hierarchy, into the history collection as raw samples taken at now.
+func SampleActiveUsersLogic(ctx context.Context, collection *mongo.Collection, now time.Time) error {
+	cursor, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to query MongoDB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	regions, err := loadRegions(ctx, collection)
+	if err != nil {
+		return err
+	}
+	hierarchy := newAreaHierarchy(regions)
+
+	byMode := map[string]int{}
+	byArea := map[string]int{}
+	for cursor.Next(ctx) {
+		var mode ModeUsage
+		if err := cursor.Decode(&mode); err != nil {
+			return fmt.Errorf("failed to decode MongoDB document: %w", err)
+		}
+		byMode[mode.ModeName] += mode.ActiveUsers
+		// Areas also count towards every parent region so any level has its own series
+		for _, area := range hierarchy.withAncestors(mode.AreaCode) {
+			byArea[area] += mode.ActiveUsers
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("cursor error: %w", err)
+	}
+
+	var samples []interface{}
+	for scope, counts := range map[string]map[string]int{HistoryScopeMode: byMode, HistoryScopeArea: byArea} {
+		for key, activeUsers := range counts {
+			samples = append(samples, ActiveUsersSample{
+				Scope:      scope,
+				Key:        key,
+				Resolution: resolutionRaw,
+				Time:       now,
+				Sum:        int64(activeUsers),
+				Count:      1,
+				Peak:       activeUsers,
+				PeakTime:   now,
+			})
+		}
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+
+	_, err = historyCollection(collection).InsertMany(ctx, samples)
+	return err
+}
+
+// DownsampleActiveUsersLogic rolls raw samples older than rawRetention up into hourly samples
+// and deletes hourly samples older than retention.
+func DownsampleActiveUsersLogic(ctx context.Context, collection *mongo.Collection, now time.Time, rawRetention, retention time.Duration) error {
+	history := historyCollection(collection)
+	rawCutoff := now.Add(-rawRetention)
+
+	filter := bson.M{"resolution": resolutionRaw, "time": bson.M{"$lt": rawCutoff}}
+	cursor, err := history.Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to query raw samples: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	type bucketKey struct {
+		scope, key string
+		hour       time.Time
+	}
+	buckets := map[bucketKey]*ActiveUsersSample{}
+	for cursor.Next(ctx) {
+		var sample ActiveUsersSample
+		if err := cursor.Decode(&sample); err != nil {
+			return fmt.Errorf("failed to decode raw sample: %w", err)
+		}
+		k := bucketKey{sample.Scope, sample.Key, sample.Time.Truncate(time.Hour)}
+		bucket, ok := buckets[k]
+		if !ok {
+			bucket = &ActiveUsersSample{Scope: sample.Scope, Key: sample.Key, Resolution: resolutionHourly, Time: k.hour, Peak: -1}
+			buckets[k] = bucket
+		}
+		bucket.Sum += sample.Sum
+		bucket.Count += sample.Count
+		if sample.Peak > bucket.Peak {
+			bucket.Peak = sample.Peak
+			bucket.PeakTime = sample.PeakTime
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("cursor error: %w", err)
+	}
+
+	for _, bucket := range buckets {
+		// A pipeline update merges into an hourly sample written by an earlier run for the same hour;
+		// every expression in the $set stage sees the document as it was before this update.
+		update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"sum":   bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$sum", 0}}, bucket.Sum}},
+			"count": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$count", 0}}, bucket.Count}},
+			"peak":  bson.M{"$max": bson.A{"$peak", bucket.Peak}},
+			"peak_time": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{bucket.Peak, bson.M{"$ifNull": bson.A{"$peak", -1}}}},
+				bucket.PeakTime,
+				"$peak_time",
+			}},
+		}}}}
+		_, err := history.UpdateOne(ctx,
+			bson.M{"scope": bucket.Scope, "key": bucket.Key, "resolution": resolutionHourly, "time": bucket.Time},
+			update,
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write hourly sample: %w", err)
+		}
+	}
+
+	if _, err := history.DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to delete raw samples: %w", err)
+	}
+	_, err = history.DeleteMany(ctx, bson.M{"time": bson.M{"$lt": now.Add(-retention)}})
+	return err
+}
+
+// GetActiveUsersHistoryLogic returns the active users of one mode or area between from and to,
+// grouped into buckets of width step. Raw and hourly samples are combined transparently.
+func GetActiveUsersHistoryLogic(ctx context.Context, collection *mongo.Collection, scope, key string, from, to time.Time, step time.Duration) (*ActiveUsersHistory, error) {
+	filter := bson.M{
+		"scope": scope,
+		"key":   key,
+		"time":  bson.M{"$gte": from, "$lt": to},
+	}
+	cursor, err := historyCollection(collection).Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "time", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	history := &ActiveUsersHistory{Peak: -1}
+	buckets := map[int64]*ActiveUsersSample{}
+	var order []int64
+	for cursor.Next(ctx) {
+		var sample ActiveUsersSample
+		if err := cursor.Decode(&sample); err != nil {
+			return nil, fmt.Errorf("failed to decode sample: %w", err)
+		}
+		index := int64(sample.Time.Sub(from) / step)
+		bucket, ok := buckets[index]
+		if !ok {
+			bucket = &ActiveUsersSample{Time: from.Add(time.Duration(index) * step), Peak: -1}
+			buckets[index] = bucket
+			order = append(order, index)
+		}
+		bucket.Sum += sample.Sum
+		bucket.Count += sample.Count
+		if sample.Peak > bucket.Peak {
+			bucket.Peak = sample.Peak
+		}
+		if sample.Peak > history.Peak {
+			history.Peak = sample.Peak
+			history.PeakTime = sample.PeakTime
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	for _, index := range order {
+		bucket := buckets[index]
+		point := HistoryPoint{Time: bucket.Time, Peak: bucket.Peak}
+		if bucket.Count > 0 {
+			point.Average = float64(bucket.Sum) / float64(bucket.Count)
+		}
+		history.Points = append(history.Points, point)
+	}
+	if history.Peak < 0 {
+		history.Peak = 0
+	}
+	return history, nil
+}
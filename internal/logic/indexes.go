@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// sibling returns the collection called name in the database of the modes collection, where every
+// other collection of the service is stored.
+func sibling(modes *mongo.Collection, name string) *mongo.Collection {
+	return modes.Database().Collection(name)
+}
+
+// EnsureIndexes creates the indexes used by the collections stored next to the modes collection.
+func EnsureIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []struct {
+		collection *mongo.Collection
+		model      mongo.IndexModel
+	}{
+		{historyCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "scope", Value: 1}, {Key: "key", Value: 1}, {Key: "resolution", Value: 1}, {Key: "time", Value: 1}},
+		}},
+		{sessionsCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "mode_name", Value: 1}, {Key: "player_id", Value: 1}, {Key: "left_at", Value: 1}},
+		}},
+		{sessionsCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "left_at", Value: 1}},
+		}},
+		{leaderboardsCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "mode_name", Value: 1}, {Key: "area_code", Value: 1}, {Key: "season", Value: 1}, {Key: "player_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{seasonsCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "season_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{seasonRecordsCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "season_id", Value: 1}, {Key: "mode_name", Value: 1}, {Key: "player_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{seasonArchiveCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "season_id", Value: 1}, {Key: "mode_name", Value: 1}, {Key: "player_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{seasonArchiveCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "season_id", Value: 1}, {Key: "mode_name", Value: 1}, {Key: "rank", Value: 1}},
+		}},
+		{sanctionsCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "player_id", Value: 1}, {Key: "type", Value: 1}, {Key: "expires_at", Value: 1}},
+		}},
+		{sanctionsCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "created_at", Value: -1}},
+		}},
+		{gameStateHistoryCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "mode_id", Value: 1}, {Key: "version", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{gameStateHistoryCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "mode_name", Value: 1}, {Key: "updated_at", Value: 1}},
+		}},
+		{gameServersCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "server_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{gameServersCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "area_code", Value: 1}, {Key: "last_heartbeat", Value: 1}},
+		}},
+		{regionsCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "area_code", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{regionsCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "parent", Value: 1}},
+		}},
+		{outboxCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "seq", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{outboxCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "at", Value: 1}},
+		}},
+		{outboxOffsetsCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "consumer", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{webhooksCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "webhook_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{webhookDeliveriesCollection(collection), mongo.IndexModel{
+			Keys:    bson.D{{Key: "webhook_id", Value: 1}, {Key: "event_id", Value: 1}, {Key: "event_type", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		}},
+		{webhookDeliveriesCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+		}},
+		{webhookDeliveriesCollection(collection), mongo.IndexModel{
+			Keys: bson.D{{Key: "created_at", Value: -1}},
+		}},
+	}
+
+	for _, index := range indexes {
+		if _, err := index.collection.Indexes().CreateOne(ctx, index.model); err != nil {
+			return fmt.Errorf("failed to create index on %s: %w", index.collection.Name(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	leaderboardsCollectionName = "leaderboards"
+
+	// dirtyLeaderboardsKey is a Redis set of the leaderboards changed since they were last persisted.
+	dirtyLeaderboardsKey = "leaderboards:dirty"
+)
+
+// Leaderboard identifies one leaderboard. AreaCode and Season are optional; an empty value means
+// the leaderboard spans every area or is not tied to a season.
+type Leaderboard struct {
+	ModeName string `json:"mode_name" bson:"mode_name"`
+	AreaCode string `json:"area_code" bson:"area_code"`
+	Season   string `json:"season" bson:"season"`
+}
+
+func (l Leaderboard) key() string {
+	return "leaderboard:" + l.ModeName + ":" + l.AreaCode + ":" + l.Season
+}
+
+// LeaderboardEntry is a player's best score and its 1-based rank, highest score first.
+type LeaderboardEntry struct {
+	PlayerID string
+	Score    float64
+	Rank     int64
+}
+
+// leaderboardScore is how a leaderboard entry is persisted in MongoDB.
+type leaderboardScore struct {
+	Leaderboard `bson:",inline"`
+	PlayerID    string    `bson:"player_id"`
+	Score       float64   `bson:"score"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+func leaderboardsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, leaderboardsCollectionName)
+}
+
+// loadLeaderboard restores a leaderboard from MongoDB when it is missing from Redis, for example
+// after a Redis flush. Scores are only raised, so submissions racing the restore are kept.
+func loadLeaderboard(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, board Leaderboard) error {
+	exists, err := redisCache.Client.Exists(ctx, board.key()).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check leaderboard: %w", err)
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	cursor, err := leaderboardsCollection(collection).Find(ctx, board)
+	if err != nil {
+		return fmt.Errorf("failed to query persisted leaderboard: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var members []redis.Z
+	for cursor.Next(ctx) {
+		var score leaderboardScore
+		if err := cursor.Decode(&score); err != nil {
+			return fmt.Errorf("failed to decode leaderboard score: %w", err)
+		}
+		members = append(members, redis.Z{Score: score.Score, Member: score.PlayerID})
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("cursor error: %w", err)
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	return redisCache.Client.ZAddArgs(ctx, board.key(), redis.ZAddArgs{GT: true, Members: members}).Err()
+}
+
+// SubmitScoreLogic records a score for a player. A leaderboard keeps each player's best score, so
+// a lower score than the current one leaves the entry unchanged. It returns the resulting entry.
+func SubmitScoreLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, board Leaderboard, playerId string, score float64) (*LeaderboardEntry, error) {
+	exists, err := collection.CountDocuments(ctx, bson.M{"mode_name": board.ModeName}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		return nil, ErrModeNotFound
+	}
+	if err := loadLeaderboard(ctx, collection, redisCache, board); err != nil {
+		return nil, err
+	}
+
+	member, err := json.Marshal(board)
+	if err != nil {
+		return nil, err
+	}
+	pipe := redisCache.Client.TxPipeline()
+	pipe.ZAddArgs(ctx, board.key(), redis.ZAddArgs{GT: true, Members: []redis.Z{{Score: score, Member: playerId}}})
+	pipe.SAdd(ctx, dirtyLeaderboardsKey, member)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to submit score: %w", err)
+	}
+
+	return GetPlayerRankLogic(ctx, collection, redisCache, board, playerId)
+}
+
+// GetTopNLogic returns the n best entries of a leaderboard.
+func GetTopNLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, board Leaderboard, n int64) ([]LeaderboardEntry, error) {
+	if err := loadLeaderboard(ctx, collection, redisCache, board); err != nil {
+		return nil, err
+	}
+	return leaderboardRange(ctx, redisCache, board, 0, n-1)
+}
+
+// GetPlayerRankLogic returns the entry of one player, or ErrPlayerNotRanked when the player has
+// not submitted a score to the leaderboard.
+func GetPlayerRankLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, board Leaderboard, playerId string) (*LeaderboardEntry, error) {
+	if err := loadLeaderboard(ctx, collection, redisCache, board); err != nil {
+		return nil, err
+	}
+
+	pipe := redisCache.Client.Pipeline()
+	rankCmd := pipe.ZRevRank(ctx, board.key(), playerId)
+	scoreCmd := pipe.ZScore(ctx, board.key(), playerId)
+	if _, err := pipe.Exec(ctx); err == redis.Nil {
+		return nil, ErrPlayerNotRanked
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read player rank: %w", err)
+	}
+
+	return &LeaderboardEntry{PlayerID: playerId, Score: scoreCmd.Val(), Rank: rankCmd.Val() + 1}, nil
+}
+
+// GetAroundPlayerLogic returns the player's entry together with up to radius entries ranked
+// directly above and below it.
+func GetAroundPlayerLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, board Leaderboard, playerId string, radius int64) ([]LeaderboardEntry, error) {
+	entry, err := GetPlayerRankLogic(ctx, collection, redisCache, board, playerId)
+	if err != nil {
+		return nil, err
+	}
+	start := entry.Rank - 1 - radius
+	if start < 0 {
+		start = 0
+	}
+	return leaderboardRange(ctx, redisCache, board, start, entry.Rank-1+radius)
+}
+
+// leaderboardRange returns the entries between the 0-based positions start and stop, inclusive.
+func leaderboardRange(ctx context.Context, redisCache *cache.RedisCache, board Leaderboard, start, stop int64) ([]LeaderboardEntry, error) {
+	members, err := redisCache.Client.ZRevRangeWithScores(ctx, board.key(), start, stop).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leaderboard: %w", err)
+	}
+	entries := make([]LeaderboardEntry, 0, len(members))
+	for i, member := range members {
+		entries = append(entries, LeaderboardEntry{
+			PlayerID: member.Member.(string),
+			Score:    member.Score,
+			Rank:     start + int64(i) + 1,
+		})
+	}
+	return entries, nil
+}
+
+// PersistLeaderboardsLogic copies every leaderboard changed since the previous run from Redis
+// into MongoDB. A leaderboard that fails to persist is marked dirty again for the next run.
+func PersistLeaderboardsLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache) error {
+	dirty, err := redisCache.Client.SMembers(ctx, dirtyLeaderboardsKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list changed leaderboards: %w", err)
+	}
+
+	var firstErr error
+	for _, member := range dirty {
+		var board Leaderboard
+		if err := json.Unmarshal([]byte(member), &board); err != nil {
+			slog.WarnContext(ctx, "Dropping malformed leaderboard reference", "member", member, "error", err)
+			redisCache.Client.SRem(ctx, dirtyLeaderboardsKey, member)
+			continue
+		}
+		// Clear the flag before reading so scores submitted while persisting mark it dirty again
+		if err := redisCache.Client.SRem(ctx, dirtyLeaderboardsKey, member).Err(); err != nil {
+			return fmt.Errorf("failed to update changed leaderboards: %w", err)
+		}
+		if err := persistLeaderboard(ctx, collection, redisCache, board); err != nil {
+			redisCache.Client.SAdd(ctx, dirtyLeaderboardsKey, member)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func persistLeaderboard(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, board Leaderboard) error {
+	members, err := redisCache.Client.ZRangeWithScores(ctx, board.key(), 0, -1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read leaderboard: %w", err)
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(members))
+	for _, member := range members {
+		playerId := member.Member.(string)
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"mode_name": board.ModeName, "area_code": board.AreaCode, "season": board.Season, "player_id": playerId}).
+			SetUpdate(bson.M{"$set": bson.M{"score": member.Score, "updated_at": now}}).
+			SetUpsert(true))
+	}
+	if _, err := leaderboardsCollection(collection).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to persist leaderboard %s: %w", board.key(), err)
+	}
+	return nil
+}
@@ -4,8 +4,10 @@ import (
     "context"
     "encoding/json"
     "multiplayer-webservice/internal/cache"
+    "multiplayer-webservice/internal/config"
     "go.mongodb.org/mongo-driver/bson"
     "go.mongodb.org/mongo-driver/mongo"
+    "go.mongodb.org/mongo-driver/mongo/options"
     "multiplayer-webservice/internal/proto"
      "fmt"
     "time"
@@ -18,13 +20,28 @@ type ModeUsage struct {
 	ActiveUsers int    `bson:"active_users"`
 	AreaCode    string `bson:"area_code"`
 	Players     []string  `bson:"players"`
+    Spectators     []string `bson:"spectators"`
+    SpectatorCount int      `bson:"spectator_count"`
     GameState   string    `bson:"game_state"`
+    // State is the structured game state; StateVersion increases with every game state update
+    State        bson.M `bson:"state,omitempty"`
+    StateVersion int64  `bson:"state_version"`
     LastUpdated time.Time `bson:"last_updated"`
+    // Schedule limits when the mode can be joined; ScheduleOpen is the availability last applied by the scheduler
+    Schedule     *ModeSchedule `bson:"schedule,omitempty"`
+    ScheduleOpen *bool         `bson:"schedule_open,omitempty"`
+    // Drain is set while the mode is draining for maintenance
+    Drain *ModeDrain `bson:"drain,omitempty"`
+    // Reservations hold seats for players who are about to join
+    Reservations []SeatReservation `bson:"reservations,omitempty"`
 	
 }
 
 
-
+// cacheTTL is how long query results stay in Redis; it follows the hot-reloadable runtime settings
+func cacheTTL() time.Duration {
+    return config.Runtime().CacheTTL
+}
 
 // InitializeCache initializes the Redis cache for the logic layer
 func GetModeUsageLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache) ([]*proto.ModeUsage, error) {
@@ -54,16 +71,21 @@ func GetModeUsageLogic(ctx context.Context, collection *mongo.Collection, redisC
         if err := cursor.Decode(&mode); err != nil {
             return nil, err
         }
+        // Modes outside their availability window are not listed
+        if !modeAvailable(ctx, mode, time.Now()) {
+            continue
+        }
         modes = append(modes, &proto.ModeUsage{
             ModeName:    mode.ModeName,
             ActiveUsers: int32(mode.ActiveUsers),
             AreaCode:    mode.AreaCode,
+            Spectators:  int32(mode.SpectatorCount),
         })
     }
 
     // Store the fetched data in the cache
     if jsonData, err := json.Marshal(modes); err == nil {
-        redisCache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute)
+        redisCache.Set(ctx, cacheKey, string(jsonData), cacheTTL())
     }
 
     return modes, nil
@@ -103,7 +125,7 @@ func GetTotalActiveUsersLogic(ctx context.Context, collection *mongo.Collection,
 
     // Cache the result
     if jsonData, err := json.Marshal(totalActiveUsers); err == nil {
-        redisCache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute)
+        redisCache.Set(ctx, cacheKey, string(jsonData), cacheTTL())
     }
 
     return totalActiveUsers, nil
@@ -135,56 +157,25 @@ func GetModeDetailsLogic(ctx context.Context, collection *mongo.Collection, cach
         Description: "Detailed description of the mode", // This can be customized or fetched from DB
         ActiveUsers: int32(mode.ActiveUsers),
         AreaCode:    mode.AreaCode,
+        Spectators:  int32(mode.SpectatorCount),
     }
 
     // Store the result in cache
     if jsonData, err := json.Marshal(modeDetails); err == nil {
-        cache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute)
+        cache.Set(ctx, cacheKey, string(jsonData), cacheTTL())
     }
 
     return modeDetails, nil
 }
 
+// GetActiveUsersByAreaCodeLogic counts the active users of an area code at any level of the region
+// hierarchy, including every area below it
 func GetActiveUsersByAreaCodeLogic(ctx context.Context, collection *mongo.Collection, cache *cache.RedisCache, areaCode string) (int32, error) {
-    // Define cache key
-    cacheKey := "active_users_area_code_" + areaCode
-
-    // Try to get data from cache
-    cachedData, err := cache.Get(ctx, cacheKey)
-    if err == nil {
-        // Cache hit: Parse and return cached result
-        var totalActiveUsers int32
-        if jsonErr := json.Unmarshal([]byte(cachedData), &totalActiveUsers); jsonErr == nil {
-            return totalActiveUsers, nil
-        }
-    }
-
-    // Cache miss: Query MongoDB
-    cursor, err := collection.Find(ctx, bson.M{"area_code": areaCode})
+    stats, err := GetAreaStatsLogic(ctx, collection, cache, areaCode)
     if err != nil {
         return 0, err
     }
-    defer cursor.Close(ctx)
-
-    totalActiveUsers := int32(0)
-    for cursor.Next(ctx) {
-        var mode ModeUsage
-        if err := cursor.Decode(&mode); err != nil {
-            return 0, err
-        }
-        totalActiveUsers += int32(mode.ActiveUsers)
-    }
-
-    if err := cursor.Err(); err != nil {
-        return 0, err
-    }
-
-    // Store the fetched data in cache
-    if jsonData, err := json.Marshal(totalActiveUsers); err == nil {
-        cache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute)
-    }
-
-    return totalActiveUsers, nil
+    return stats.ActiveUsers, nil
 }
 
 func GetGameModeStatsLogic(ctx context.Context, collection *mongo.Collection, cache *cache.RedisCache) (*proto.GameModeStatsResponse, error) {
@@ -214,12 +205,14 @@ func GetGameModeStatsLogic(ctx context.Context, collection *mongo.Collection, ca
     defer cursor.Close(ctx)
 
     totalActiveUsers := int32(0)
+    totalSpectators := int32(0)
     for cursor.Next(ctx) {
         var mode ModeUsage
         if err := cursor.Decode(&mode); err != nil {
             return nil, err
         }
         totalActiveUsers += int32(mode.ActiveUsers)
+        totalSpectators += int32(mode.SpectatorCount)
     }
 
     if err := cursor.Err(); err != nil {
@@ -229,36 +222,108 @@ func GetGameModeStatsLogic(ctx context.Context, collection *mongo.Collection, ca
     stats := &proto.GameModeStatsResponse{
         TotalModes:       int32(totalModes),
         TotalActiveUsers: totalActiveUsers,
+        TotalSpectators:  totalSpectators,
     }
 
     // Store the fetched statistics in cache
     if jsonData, err := json.Marshal(stats); err == nil {
-        cache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute)
+        cache.Set(ctx, cacheKey, string(jsonData), cacheTTL())
     }
 
     return stats, nil
 }
 
 func JoinModeLogic(ctx context.Context, collection *mongo.Collection, cache *cache.RedisCache, modeName, playerId string) error {
+    return JoinModeWithReservationLogic(ctx, collection, cache, modeName, playerId, "")
+}
+
+// JoinModeWithReservationLogic adds a player to a mode. With a reservation token the player takes the seat
+// reserved by ReserveSeatLogic; without one a free seat is needed
+func JoinModeWithReservationLogic(ctx context.Context, collection *mongo.Collection, cache *cache.RedisCache, modeName, playerId, reservationToken string) error {
+    now := time.Now()
+
+    // Reject joins to draining modes and outside the mode's availability window
+    if err := checkModeJoinable(ctx, collection, modeName, now); err != nil {
+        return err
+    }
+
+    // Keep banned players out
+    if err := checkNotBanned(ctx, collection, modeName, playerId, now); err != nil {
+        return err
+    }
+
     // Update MongoDB: Add the player and increment active users
-    filter := bson.M{"mode_name": modeName}
+    filter := bson.M{"mode_name": modeName, "drain": bson.M{"$exists": false}}
     update := bson.M{
         "$inc": bson.M{"active_users": 1},
         "$push": bson.M{"players": playerId},
-        "$set": bson.M{"last_updated": time.Now()},
+        "$set": bson.M{"last_updated": now},
+    }
+    if reservationToken != "" {
+        // The reserved seat is already counted, so only the reservation has to be valid
+        filter["reservations"] = bson.M{"$elemMatch": bson.M{"token": reservationToken, "player_id": playerId, "expires_at": bson.M{"$gt": now}}}
+        update["$pull"] = bson.M{"reservations": bson.M{"token": reservationToken}}
+    } else {
+        // A reservation the player holds is used up rather than kept on top of the new seat
+        update["$pull"] = bson.M{"reservations": bson.M{"player_id": playerId}}
+        hasFreeSeat(filter, modeName, playerId, now)
+    }
+
+    // The mode as it was before the join, so the event can tell whether the player already held a seat
+    var joined ModeUsage
+    opts := options.FindOneAndUpdate().
+        SetProjection(bson.M{"area_code": 1, "active_users": 1, "reservations": 1})
+    err := updateWithOutbox(ctx, collection, func(ctx context.Context) (*OutboxEvent, error) {
+        if err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&joined); err != nil {
+            return nil, err
+        }
+        reserved, heldSeat := 0, false
+        for _, reservation := range joined.Reservations {
+            if !reservation.ExpiresAt.After(now) {
+                continue
+            }
+            if reservation.PlayerID == playerId {
+                heldSeat = true
+                continue
+            }
+            reserved++
+        }
+        data := bson.M{
+            "area_code":    joined.AreaCode,
+            "active_users": joined.ActiveUsers + 1,
+            "reserved":     reserved,
+            "reservation":  heldSeat,
+            "capacity":     config.Runtime().ModeCapacity(modeName),
+        }
+        return &OutboxEvent{Type: OutboxEventPlayerJoined, ModeName: modeName, PlayerID: playerId, Data: data, At: time.Now()}, nil
+    })
+    if err == mongo.ErrNoDocuments {
+        // Nothing matched: the mode is gone, started draining since the check above, is full or the reservation is not valid
+        if err := checkModeJoinable(ctx, collection, modeName, time.Now()); err != nil {
+            return err
+        }
+        if reservationToken != "" {
+            return ErrReservationInvalid
+        }
+        return ErrModeFull
     }
-
-    _, err := collection.UpdateOne(ctx, filter, update)
     if err != nil {
         return err
     }
 
+    // Record when the player joined for session analytics
+    startSession(ctx, collection, modeName, joined.AreaCode, playerId, time.Now())
+
+    // Count the player towards the daily and monthly unique players
+    recordUniquePlayer(ctx, collection, cache, modeName, joined.AreaCode, playerId, time.Now())
+
     // Cache Invalidation: Remove cache entries related to this mode
     modeCacheKey := "mode_details_" + modeName
     statsCacheKey := "game_mode_stats"
 
     cache.Delete(ctx, modeCacheKey) // Invalidate mode details cache
     cache.Delete(ctx, statsCacheKey) // Invalidate game statistics cache
+    invalidateAreaStats(ctx, cache, areaWithAncestors(ctx, collection, cache, joined.AreaCode)...) // Invalidate the totals of the area and the regions above it
 
     return nil
 }
@@ -272,16 +337,35 @@ func LeaveModeLogic(ctx context.Context, collection *mongo.Collection, redisCach
         "$set": bson.M{"last_updated": time.Now()}, // Update timestamp
     }
 
-    _, err := collection.UpdateOne(ctx, filter, update)
+    var left ModeUsage
+    opts := options.FindOneAndUpdate().
+        SetProjection(bson.M{"mode_name": 1, "area_code": 1, "active_users": 1, "drain": 1}).
+        SetReturnDocument(options.After)
+    err := updateWithOutbox(ctx, collection, func(ctx context.Context) (*OutboxEvent, error) {
+        if err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&left); err != nil {
+            return nil, err
+        }
+        return playerLeftEvent(left, playerId, SessionEndLeft), nil
+    })
+    if err == mongo.ErrNoDocuments {
+        // No such mode, so there is nothing to end, announce or invalidate
+        return nil
+    }
     if err != nil {
         return err
     }
 
+    endSession(ctx, collection, modeName, playerId, SessionEndLeft, time.Now())
+
+    // Let automation know once a draining mode is empty
+    notifyIfDrained(ctx, redisCache, left)
+
     // Invalidate cache for the mode and related data
     modeCacheKey := "mode_details_" + modeName
     statsCacheKey := "game_mode_stats"
     redisCache.Delete(ctx, modeCacheKey) // Invalidate mode details cache
     redisCache.Delete(ctx, statsCacheKey) // Invalidate game statistics cache
+    invalidateAreaStats(ctx, redisCache, areaWithAncestors(ctx, collection, redisCache, left.AreaCode)...) // Invalidate the totals of the area and the regions above it
 
     return nil
 }
@@ -312,54 +396,14 @@ func GetPlayersLogic(ctx context.Context, collection *mongo.Collection, redisCac
 
     // Store the players list in the cache
     if jsonData, err := json.Marshal(result.Players); err == nil {
-        redisCache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute)
+        redisCache.Set(ctx, cacheKey, string(jsonData), cacheTTL())
     }
 
     return result.Players, nil
 }
 
-// UpdateGameStateLogic updates the game state of a mode and handles cache synchronization
+// UpdateGameStateLogic sets the status part of a mode's game state, leaving the structured state as it is
 func UpdateGameStateLogic(ctx context.Context, collection *mongo.Collection, cache *cache.RedisCache, modeName, gameState string) error {
-	// Define the cache key based on the mode name
-	cacheKey := "mode_" + modeName
-
-	// Try to get the data from the cache
-	cachedData, err := cache.Get(ctx, cacheKey)
-	if err == nil {
-		// Cache hit: Unmarshal the cached data and update the game state
-		var mode ModeUsage
-		if jsonErr := json.Unmarshal([]byte(cachedData), &mode); jsonErr == nil {
-			mode.GameState = gameState
-
-			// Store the updated mode in the cache
-			if jsonData, err := json.Marshal(mode); err == nil {
-				cache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute) // Cache TTL set to 10 minutes
-			}
-		}
-	}
-
-	// Update the game state in the MongoDB collection
-	filter := bson.M{"mode_name": modeName}
-	update := bson.M{
-		"$set": bson.M{"game_state": gameState, "last_updated": time.Now()},
-	}
-
-	_, err = collection.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return err
-	}
-
-	// After MongoDB update, also update the cache with the latest data
-	var updatedMode ModeUsage
-	err = collection.FindOne(ctx, bson.M{"mode_name": modeName}).Decode(&updatedMode)
-	if err != nil {
-		return err
-	}
-
-	// Store the updated mode in the cache
-	if jsonData, err := json.Marshal(updatedMode); err == nil {
-		cache.Set(ctx, cacheKey, string(jsonData), 10*time.Minute) // Cache TTL set to 10 minutes
-	}
-
-	return nil
+	_, err := PatchGameStateLogic(ctx, collection, cache, modeName, GameStatePatch{Status: gameState})
+	return err
 }
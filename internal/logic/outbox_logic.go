@@ -0,0 +1,219 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	outboxCollectionName         = "outbox"
+	outboxOffsetsCollectionName  = "outbox_offsets"
+	outboxSequenceCollectionName = "outbox_sequence"
+
+	// OutboxEventPlayerJoined, OutboxEventPlayerLeft and OutboxEventGameStateChanged are the types of
+	// the change events written to the outbox.
+	OutboxEventPlayerJoined     = "player_joined"
+	OutboxEventPlayerLeft       = "player_left"
+	OutboxEventGameStateChanged = "game_state_changed"
+)
+
+// OutboxEvent is one change to a mode, written to the outbox together with the change itself and
+// relayed to the configured sinks. Seq numbers events in the order their transactions committed, so
+// consumers can use the Seq of the last event they handled as their offset.
+type OutboxEvent struct {
+	ID       primitive.ObjectID `bson:"_id" json:"id"`
+	Seq      int64              `bson:"seq" json:"seq"`
+	Type     string             `bson:"type" json:"type"`
+	ModeName string             `bson:"mode_name" json:"mode_name"`
+	PlayerID string             `bson:"player_id,omitempty" json:"player_id,omitempty"`
+	Data     bson.M             `bson:"data,omitempty" json:"data,omitempty"`
+	At       time.Time          `bson:"at" json:"at"`
+}
+
+// OutboxConsumer is the delivery progress of one sink.
+type OutboxConsumer struct {
+	Name      string    `bson:"consumer"`
+	LastSeq   int64     `bson:"last_seq"`
+	UpdatedAt time.Time `bson:"updated_at"`
+	// Pending counts the events in the outbox the consumer has not been sent yet.
+	Pending int64 `bson:"-"`
+}
+
+func outboxCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, outboxCollectionName)
+}
+
+func outboxOffsetsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, outboxOffsetsCollectionName)
+}
+
+func outboxSequenceCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, outboxSequenceCollectionName)
+}
+
+// CheckTransactions returns an error unless client is connected to a deployment that can run
+// transactions, a replica set (a single member is enough) or a sharded cluster. Changes and their
+// outbox events are written in one transaction, so the service does not start without one.
+func CheckTransactions(ctx context.Context, client *mongo.Client) error {
+	var hello bson.M
+	if err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return fmt.Errorf("failed to check for transaction support: %w", err)
+	}
+	if _, replicaSet := hello["setName"]; !replicaSet && hello["msg"] != "isdbgrid" {
+		return errors.New("MongoDB is a standalone server, which cannot run transactions; run it as a replica set")
+	}
+	return nil
+}
+
+// updateWithOutbox runs update and writes the event it returns to the outbox in the same
+// transaction, so an event exists exactly when its change was committed. A nil event writes
+// nothing. The event takes the next number of the outbox sequence inside the transaction; every
+// transaction with an event writes that counter, so they commit one at a time in sequence order.
+func updateWithOutbox(ctx context.Context, collection *mongo.Collection, update func(ctx context.Context) (*OutboxEvent, error)) error {
+	session, err := collection.Database().Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		event, err := update(sc)
+		if err != nil || event == nil {
+			return nil, err
+		}
+		event = newOutboxEvent(event)
+		// Taken last, so concurrent transactions conflict on the counter as briefly as possible
+		if event.Seq, err = nextOutboxSeq(sc, collection); err != nil {
+			return nil, err
+		}
+		_, err = outboxCollection(collection).InsertOne(sc, event)
+		return nil, err
+	})
+	return err
+}
+
+func nextOutboxSeq(ctx context.Context, collection *mongo.Collection) (int64, error) {
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := outboxSequenceCollection(collection).FindOneAndUpdate(ctx, bson.M{"_id": outboxCollectionName}, bson.M{"$inc": bson.M{"seq": 1}}, opts).Decode(&counter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to number outbox event: %w", err)
+	}
+	return counter.Seq, nil
+}
+
+func newOutboxEvent(event *OutboxEvent) *OutboxEvent {
+	if event.ID.IsZero() {
+		event.ID = primitive.NewObjectID()
+	}
+	if event.At.IsZero() {
+		event.At = time.Now()
+	}
+	return event
+}
+
+// playerLeftEvent describes a player leaving mode, as it is after the player left, for reason
+// SessionEndLeft or SessionEndKicked.
+func playerLeftEvent(mode ModeUsage, playerId, reason string) *OutboxEvent {
+	data := bson.M{"active_users": mode.ActiveUsers, "reason": reason}
+	return &OutboxEvent{Type: OutboxEventPlayerLeft, ModeName: mode.ModeName, PlayerID: playerId, Data: data}
+}
+
+// OutboxSink receives the events relayed from the outbox. Each sink keeps its own offset under
+// Name, so renaming a sink makes it start again from the oldest retained event.
+type OutboxSink interface {
+	Name() string
+	// Publish delivers events in order. On error the whole batch is sent again later, so sinks
+	// and their consumers must tolerate duplicates.
+	Publish(ctx context.Context, events []OutboxEvent) error
+}
+
+func outboxOffset(ctx context.Context, collection *mongo.Collection, consumer string) (int64, error) {
+	var offset OutboxConsumer
+	err := outboxOffsetsCollection(collection).FindOne(ctx, bson.M{"consumer": consumer}).Decode(&offset)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return 0, fmt.Errorf("failed to read outbox offset: %w", err)
+	}
+	return offset.LastSeq, nil
+}
+
+// RelayOutboxLogic sends the events written after sink's offset to sink in batches of batchSize,
+// advancing the offset after each delivered batch, and returns how many events were delivered.
+// Sequence numbers follow commit order, so no event can appear behind an offset already stored.
+// Delivery is at least once; a failed batch is retried from the same offset.
+func RelayOutboxLogic(ctx context.Context, collection *mongo.Collection, sink OutboxSink, batchSize int64) (int, error) {
+	offset, err := outboxOffset(ctx, collection, sink.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for {
+		filter := bson.M{"seq": bson.M{"$gt": offset}}
+		opts := options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}).SetLimit(batchSize)
+		cursor, err := outboxCollection(collection).Find(ctx, filter, opts)
+		if err != nil {
+			return delivered, fmt.Errorf("failed to read outbox: %w", err)
+		}
+		var events []OutboxEvent
+		if err := cursor.All(ctx, &events); err != nil {
+			return delivered, fmt.Errorf("failed to decode outbox events: %w", err)
+		}
+		if len(events) == 0 {
+			return delivered, nil
+		}
+		for i := range events {
+			events[i].Data = plainDocument(events[i].Data)
+		}
+
+		if err := sink.Publish(ctx, events); err != nil {
+			return delivered, fmt.Errorf("failed to deliver outbox events to %s: %w", sink.Name(), err)
+		}
+		offset = events[len(events)-1].Seq
+		update := bson.M{"$set": bson.M{"last_seq": offset, "updated_at": time.Now()}}
+		if _, err := outboxOffsetsCollection(collection).UpdateOne(ctx, bson.M{"consumer": sink.Name()}, update, options.Update().SetUpsert(true)); err != nil {
+			return delivered, fmt.Errorf("failed to store outbox offset: %w", err)
+		}
+		delivered += len(events)
+		if int64(len(events)) < batchSize {
+			return delivered, nil
+		}
+	}
+}
+
+// ListOutboxConsumersLogic returns the offset of every sink that has received events, with the
+// number of events still waiting for it.
+func ListOutboxConsumersLogic(ctx context.Context, collection *mongo.Collection) ([]OutboxConsumer, error) {
+	cursor, err := outboxOffsetsCollection(collection).Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "consumer", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox offsets: %w", err)
+	}
+	var consumers []OutboxConsumer
+	if err := cursor.All(ctx, &consumers); err != nil {
+		return nil, fmt.Errorf("failed to decode outbox offsets: %w", err)
+	}
+	for i := range consumers {
+		pending, err := outboxCollection(collection).CountDocuments(ctx, bson.M{"seq": bson.M{"$gt": consumers[i].LastSeq}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count pending outbox events: %w", err)
+		}
+		consumers[i].Pending = pending
+	}
+	return consumers, nil
+}
+
+// PruneOutboxLogic deletes the events written before now minus retention, delivered or not.
+func PruneOutboxLogic(ctx context.Context, collection *mongo.Collection, retention time.Duration, now time.Time) error {
+	if _, err := outboxCollection(collection).DeleteMany(ctx, bson.M{"at": bson.M{"$lt": now.Add(-retention)}}); err != nil {
+		return fmt.Errorf("failed to prune outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ParseOutboxSink builds a sink from its configuration, one of:
+//
+//	stdout               one JSON event per line on standard output
+//	file:<path>          one JSON event per line appended to path
+//	redis-stream:<key>   XADD to the Redis stream key
+//	webhook:<url>        POST each batch to url as a JSON array
+func ParseOutboxSink(spec string, redisCache *cache.RedisCache) (OutboxSink, error) {
+	kind, target, _ := strings.Cut(spec, ":")
+	switch {
+	case spec == "stdout":
+		return NewNDJSONSink("stdout", os.Stdout), nil
+	case kind == "file" && target != "":
+		file, err := os.OpenFile(target, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open outbox file: %w", err)
+		}
+		return NewNDJSONSink(spec, file), nil
+	case kind == "redis-stream" && target != "":
+		return NewRedisStreamSink(redisCache, target), nil
+	case kind == "webhook" && target != "":
+		return NewWebhookSink(target, 10*time.Second), nil
+	}
+	return nil, fmt.Errorf("unknown outbox sink %q", spec)
+}
+
+// NDJSONSink writes one JSON document per event and line.
+type NDJSONSink struct {
+	name string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+// NewNDJSONSink initializes an NDJSONSink writing to w.
+func NewNDJSONSink(name string, w io.Writer) *NDJSONSink {
+	return &NDJSONSink{name: name, w: w}
+}
+
+func (s *NDJSONSink) Name() string {
+	return s.name
+}
+
+func (s *NDJSONSink) Publish(ctx context.Context, events []OutboxEvent) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.w.Write(buf.Bytes())
+	return err
+}
+
+// RedisStreamSink appends events to a Redis stream. Consumers read it with XREAD or consumer
+// groups; the event ID is included so they can drop the duplicates at-least-once delivery allows.
+type RedisStreamSink struct {
+	redisCache *cache.RedisCache
+	stream     string
+}
+
+// NewRedisStreamSink initializes a RedisStreamSink appending to stream.
+func NewRedisStreamSink(redisCache *cache.RedisCache, stream string) *RedisStreamSink {
+	return &RedisStreamSink{redisCache: redisCache, stream: stream}
+}
+
+func (s *RedisStreamSink) Name() string {
+	return "redis-stream:" + s.stream
+}
+
+func (s *RedisStreamSink) Publish(ctx context.Context, events []OutboxEvent) error {
+	pipe := s.redisCache.Client.Pipeline()
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: s.stream,
+			Values: map[string]interface{}{"id": event.ID.Hex(), "type": event.Type, "mode_name": event.ModeName, "event": payload},
+		})
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// WebhookSink POSTs each batch of events to a URL as a JSON array. Any status other than 2xx
+// fails the batch.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink initializes a WebhookSink giving up on a delivery after timeout.
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook:" + s.url
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, events []OutboxEvent) error {
+	payload, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
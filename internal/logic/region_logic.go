@@ -0,0 +1,238 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	regionsCollectionName = "regions"
+	regionsCacheKey       = "regions"
+)
+
+// Region is an entry of the region catalog. Parent names the enclosing region, if any; Endpoints
+// are the addresses clients connect to in the region.
+type Region struct {
+	AreaCode  string   `bson:"area_code" json:"area_code"`
+	Name      string   `bson:"name" json:"name"`
+	Parent    string   `bson:"parent,omitempty" json:"parent,omitempty"`
+	Endpoints []string `bson:"endpoints" json:"endpoints"`
+}
+
+// AreaCandidate is one area considered by RecommendAreaLogic. Lower scores are better.
+type AreaCandidate struct {
+	Region      Region
+	LatencyMs   int
+	ActiveUsers int32
+	Score       float64
+}
+
+func regionsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, regionsCollectionName)
+}
+
+func playerLatencyKey(playerId string) string {
+	return "player_latency:" + playerId
+}
+
+// ListRegionsLogic returns the region catalog ordered by area code.
+func ListRegionsLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache) ([]Region, error) {
+	if cachedData, err := redisCache.Get(ctx, regionsCacheKey); err == nil {
+		var regions []Region
+		if jsonErr := json.Unmarshal([]byte(cachedData), &regions); jsonErr == nil {
+			return regions, nil
+		}
+	}
+
+	regions, err := loadRegions(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if jsonData, err := json.Marshal(regions); err == nil {
+		redisCache.Set(ctx, regionsCacheKey, string(jsonData), cacheTTL())
+	}
+	return regions, nil
+}
+
+// PutRegionLogic adds a region to the catalog or replaces it. The parent must already be in the
+// catalog and may not be the region itself or one of its descendants.
+func PutRegionLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, region Region) error {
+	regions, err := ListRegionsLogic(ctx, collection, redisCache)
+	if err != nil {
+		return err
+	}
+	h := newAreaHierarchy(regions)
+	if region.Parent != "" {
+		parents := make(map[string]string, len(regions))
+		for _, r := range regions {
+			parents[r.AreaCode] = r.Parent
+		}
+		if _, ok := parents[region.Parent]; !ok {
+			return fmt.Errorf("%w: parent %s", ErrRegionNotFound, region.Parent)
+		}
+		for code := region.Parent; code != ""; code = parents[code] {
+			if code == region.AreaCode {
+				return fmt.Errorf("%w: %s cannot be nested inside itself", ErrInvalidRegion, region.AreaCode)
+			}
+		}
+	}
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := regionsCollection(collection).ReplaceOne(ctx, bson.M{"area_code": region.AreaCode}, region, opts); err != nil {
+		return fmt.Errorf("failed to store region: %w", err)
+	}
+	redisCache.Delete(ctx, regionsCacheKey)
+	// The region's totals move from the regions it was under to the ones it is under now
+	invalidateAreaStats(ctx, redisCache, h.withAncestors(region.AreaCode)...)
+	if region.Parent != "" {
+		invalidateAreaStats(ctx, redisCache, h.withAncestors(region.Parent)...)
+	}
+	return nil
+}
+
+// DeleteRegionLogic removes a region from the catalog. Regions that still have child regions cannot be removed.
+func DeleteRegionLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, areaCode string) error {
+	ancestors := areaWithAncestors(ctx, collection, redisCache, areaCode)
+	children, err := regionsCollection(collection).CountDocuments(ctx, bson.M{"parent": areaCode}, options.Count().SetLimit(1))
+	if err != nil {
+		return fmt.Errorf("failed to check child regions: %w", err)
+	}
+	if children > 0 {
+		return fmt.Errorf("%w: %s still has child regions", ErrInvalidRegion, areaCode)
+	}
+
+	result, err := regionsCollection(collection).DeleteOne(ctx, bson.M{"area_code": areaCode})
+	if err != nil {
+		return fmt.Errorf("failed to delete region: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrRegionNotFound
+	}
+	redisCache.Delete(ctx, regionsCacheKey)
+	invalidateAreaStats(ctx, redisCache, ancestors...)
+	return nil
+}
+
+// ReportLatenciesLogic stores the latencies a player measured to each area, replacing the previous
+// report. Reports are forgotten after ttl. Every area must be in the region catalog.
+func ReportLatenciesLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, playerId string, latencies map[string]int, ttl time.Duration) error {
+	regions, err := ListRegionsLogic(ctx, collection, redisCache)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		known[region.AreaCode] = true
+	}
+	fields := make(map[string]interface{}, len(latencies))
+	for area, ms := range latencies {
+		if !known[area] {
+			return fmt.Errorf("%w: %s", ErrRegionNotFound, area)
+		}
+		fields[area] = ms
+	}
+
+	key := playerLatencyKey(playerId)
+	pipe := redisCache.Client.TxPipeline()
+	pipe.Del(ctx, key)
+	if len(fields) > 0 {
+		pipe.HSet(ctx, key, fields)
+		pipe.Expire(ctx, key, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store latencies: %w", err)
+	}
+	return nil
+}
+
+// modeLoadByArea returns the active users of modeName in each area it is placed in directly.
+func modeLoadByArea(ctx context.Context, collection *mongo.Collection, modeName string) (map[string]int32, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"mode_name": modeName}}},
+		{{Key: "$group", Value: bson.M{"_id": "$area_code", "active_users": bson.M{"$sum": "$active_users"}}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate mode load: %w", err)
+	}
+	var areas []struct {
+		AreaCode    string `bson:"_id"`
+		ActiveUsers int64  `bson:"active_users"`
+	}
+	if err := cursor.All(ctx, &areas); err != nil {
+		return nil, fmt.Errorf("failed to decode mode load: %w", err)
+	}
+	load := make(map[string]int32, len(areas))
+	for _, area := range areas {
+		load[area.AreaCode] = int32(area.ActiveUsers)
+	}
+	return load, nil
+}
+
+// RecommendAreaLogic ranks the areas a player reported latencies for to play modeName, best first.
+// Each area scores its latency in milliseconds plus loadPenalty per active user of modeName in the
+// area and the areas below it, so a slightly slower but much quieter area can win. It returns
+// ErrModeNotFound for an unknown mode and ErrNoLatencies when the player has no current report.
+func RecommendAreaLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, playerId string, loadPenalty float64) ([]AreaCandidate, error) {
+	load, err := modeLoadByArea(ctx, collection, modeName)
+	if err != nil {
+		return nil, err
+	}
+	if len(load) == 0 {
+		return nil, ErrModeNotFound
+	}
+
+	reported, err := redisCache.Client.HGetAll(ctx, playerLatencyKey(playerId)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read latencies: %w", err)
+	}
+	regions, err := ListRegionsLogic(ctx, collection, redisCache)
+	if err != nil {
+		return nil, err
+	}
+	h := newAreaHierarchy(regions)
+
+	var candidates []AreaCandidate
+	for _, region := range regions {
+		raw, ok := reported[region.AreaCode]
+		if !ok {
+			continue
+		}
+		latency, err := strconv.Atoi(raw)
+		if err != nil {
+			continue
+		}
+		var activeUsers int32
+		for _, area := range h.subtree(region.AreaCode) {
+			activeUsers += load[area]
+		}
+		candidates = append(candidates, AreaCandidate{
+			Region:      region,
+			LatencyMs:   latency,
+			ActiveUsers: activeUsers,
+			Score:       float64(latency) + loadPenalty*float64(activeUsers),
+		})
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoLatencies
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score < candidates[j].Score
+		}
+		return candidates[i].Region.AreaCode < candidates[j].Region.AreaCode
+	})
+	return candidates, nil
+}
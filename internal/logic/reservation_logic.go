@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/config"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// SeatReservation holds a seat in a mode for one player until ExpiresAt. The player takes the seat
+// by joining with Token; expired reservations no longer count against the mode's capacity.
+type SeatReservation struct {
+	Token     string    `bson:"token"`
+	PlayerID  string    `bson:"player_id"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+func newReservationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// seatsTaken is the aggregation expression counting the players in a mode plus the reservations
+// still valid at now. The reservation of exceptPlayer, if any, is left out because the player is
+// about to replace or take it.
+func seatsTaken(exceptPlayer string, now time.Time) bson.M {
+	cond := bson.M{"$gt": bson.A{"$$this.expires_at", now}}
+	if exceptPlayer != "" {
+		cond = bson.M{"$and": bson.A{cond, bson.M{"$ne": bson.A{"$$this.player_id", bson.M{"$literal": exceptPlayer}}}}}
+	}
+	active := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$reservations", bson.A{}}},
+		"cond":  cond,
+	}}
+	return bson.M{"$add": bson.A{"$active_users", bson.M{"$size": active}}}
+}
+
+// hasFreeSeat adds the capacity condition to a mode filter for playerId; a capacity of 0 means unlimited.
+func hasFreeSeat(filter bson.M, modeName, playerId string, now time.Time) {
+	if capacity := config.Runtime().ModeCapacity(modeName); capacity > 0 {
+		// Only match while there is room so concurrent joins and reservations cannot overshoot the capacity
+		filter["$expr"] = bson.M{"$lt": bson.A{seatsTaken(playerId, now), capacity}}
+	}
+}
+
+// ReserveSeatLogic holds a seat in a mode for a player for ttl. Reserving again replaces the
+// player's previous reservation. The same checks as for joining apply, and reservations count
+// against the mode's capacity, so it returns ErrModeFull when no seat is free.
+func ReserveSeatLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, playerId string, ttl time.Duration) (*SeatReservation, error) {
+	now := time.Now()
+	if err := checkModeJoinable(ctx, collection, modeName, now); err != nil {
+		return nil, err
+	}
+	if err := checkNotBanned(ctx, collection, modeName, playerId, now); err != nil {
+		return nil, err
+	}
+
+	token, err := newReservationToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate reservation token: %w", err)
+	}
+	reservation := &SeatReservation{Token: token, PlayerID: playerId, ExpiresAt: now.Add(ttl)}
+
+	// Swap out an earlier reservation of the player in the same write, so renewing never needs a
+	// second seat and never loses the first one
+	filter := bson.M{"mode_name": modeName, "drain": bson.M{"$exists": false}}
+	hasFreeSeat(filter, modeName, playerId, now)
+	others := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$reservations", bson.A{}}},
+		"cond":  bson.M{"$ne": bson.A{"$$this.player_id", bson.M{"$literal": playerId}}},
+	}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"reservations": bson.M{"$concatArrays": bson.A{others, bson.A{bson.M{"$literal": reservation}}}},
+	}}}}
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		if err := checkModeJoinable(ctx, collection, modeName, time.Now()); err != nil {
+			return nil, err
+		}
+		return nil, ErrModeFull
+	}
+
+	redisCache.Delete(ctx, "mode_details:"+modeName)
+	return reservation, nil
+}
+
+// ReleaseExpiredReservationsLogic removes the reservations that expired before now from every mode.
+// Expired reservations already stop counting against capacity; this only keeps the documents small.
+func ReleaseExpiredReservationsLogic(ctx context.Context, collection *mongo.Collection, now time.Time) error {
+	filter := bson.M{"reservations.expires_at": bson.M{"$lte": now}}
+	update := bson.M{"$pull": bson.M{"reservations": bson.M{"expires_at": bson.M{"$lte": now}}}}
+	if _, err := collection.UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to release expired reservations: %w", err)
+	}
+	return nil
+}
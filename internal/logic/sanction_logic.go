@@ -0,0 +1,272 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	sanctionsCollectionName = "sanctions"
+
+	// SanctionKick, SanctionBan and SanctionMute are the kinds of sanction a moderator can issue.
+	SanctionKick = "kick"
+	SanctionBan  = "ban"
+	SanctionMute = "mute"
+
+	// SessionEndKicked records a session closed by a kick or a ban.
+	SessionEndKicked = "kicked"
+)
+
+// Sanction is a moderation action against a player. ModeName scopes it to one mode; an empty
+// ModeName applies to every mode. A ban or mute without ExpiresAt is permanent; timed ones stop
+// applying once ExpiresAt has passed and stay listed for moderators.
+type Sanction struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Type      string             `bson:"type"`
+	PlayerID  string             `bson:"player_id"`
+	ModeName  string             `bson:"mode_name"`
+	Reason    string             `bson:"reason"`
+	IssuedBy  string             `bson:"issued_by"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt *time.Time         `bson:"expires_at"`
+}
+
+// SanctionFilter selects the sanctions returned by ListSanctionsLogic; empty fields match everything.
+type SanctionFilter struct {
+	PlayerID       string
+	ModeName       string
+	IncludeExpired bool
+	Limit          int64
+}
+
+func sanctionsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, sanctionsCollectionName)
+}
+
+// notExpired is the $or clause matching sanctions that still apply at now.
+func notExpired(now time.Time) bson.A {
+	return bson.A{bson.M{"expires_at": nil}, bson.M{"expires_at": bson.M{"$gt": now}}}
+}
+
+// checkNotBanned returns an error wrapping ErrPlayerBanned when an active ban keeps the player out of the mode.
+func checkNotBanned(ctx context.Context, collection *mongo.Collection, modeName, playerId string, now time.Time) error {
+	filter := bson.M{
+		"type":      SanctionBan,
+		"player_id": playerId,
+		"mode_name": bson.M{"$in": bson.A{modeName, ""}},
+		"$or":       notExpired(now),
+	}
+	cursor, err := sanctionsCollection(collection).Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to check bans: %w", err)
+	}
+	var bans []Sanction
+	if err := cursor.All(ctx, &bans); err != nil {
+		return fmt.Errorf("failed to decode bans: %w", err)
+	}
+	if len(bans) == 0 {
+		return nil
+	}
+
+	// Report the ban that lasts longest
+	var until *time.Time
+	for i, ban := range bans {
+		if ban.ExpiresAt == nil {
+			return fmt.Errorf("%w permanently", ErrPlayerBanned)
+		}
+		if i == 0 || ban.ExpiresAt.After(*until) {
+			until = ban.ExpiresAt
+		}
+	}
+	return fmt.Errorf("%w until %s", ErrPlayerBanned, until.UTC().Format(time.RFC3339))
+}
+
+// removeFromMode takes a player out of a mode on behalf of a moderator. It reports whether the
+// player was in the mode; unlike LeaveModeLogic nothing changes when they were not.
+func removeFromMode(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, playerId string, now time.Time) (bool, error) {
+	filter := bson.M{"mode_name": modeName, "players": playerId}
+	update := bson.M{
+		"$inc":  bson.M{"active_users": -1},
+		"$pull": bson.M{"players": playerId},
+		"$set":  bson.M{"last_updated": now},
+	}
+	var mode ModeUsage
+	opts := options.FindOneAndUpdate().
+		SetProjection(bson.M{"mode_name": 1, "area_code": 1, "active_users": 1, "drain": 1}).
+		SetReturnDocument(options.After)
+	err := updateWithOutbox(ctx, collection, func(ctx context.Context) (*OutboxEvent, error) {
+		if err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&mode); err != nil {
+			return nil, err
+		}
+		return playerLeftEvent(mode, playerId, SessionEndKicked), nil
+	})
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	endSession(ctx, collection, modeName, playerId, SessionEndKicked, now)
+	notifyIfDrained(ctx, redisCache, mode)
+	redisCache.Delete(ctx, "mode_details:"+modeName)
+	invalidateAreaStats(ctx, redisCache, areaWithAncestors(ctx, collection, redisCache, mode.AreaCode)...)
+	redisCache.Delete(ctx, "players_list_"+modeName)
+	redisCache.Delete(ctx, "game_mode_stats")
+	return true, nil
+}
+
+// KickPlayerLogic removes a player from a mode and records the kick. It returns ErrPlayerNotInMode
+// when the player is not in the mode.
+func KickPlayerLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, playerId, reason, issuedBy string) error {
+	now := time.Now()
+	removed, err := removeFromMode(ctx, collection, redisCache, modeName, playerId, now)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrPlayerNotInMode
+	}
+
+	_, err = sanctionsCollection(collection).InsertOne(ctx, Sanction{
+		Type:      SanctionKick,
+		PlayerID:  playerId,
+		ModeName:  modeName,
+		Reason:    reason,
+		IssuedBy:  issuedBy,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record kick: %w", err)
+	}
+	return nil
+}
+
+// BanPlayerLogic bans a player from one mode, or from every mode when modeName is empty, for
+// duration (forever when zero). The player is removed from the modes the ban covers, as a player and as a spectator.
+func BanPlayerLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, playerId, modeName string, duration time.Duration, reason, issuedBy string) (*Sanction, error) {
+	now := time.Now()
+	ban := &Sanction{
+		Type:      SanctionBan,
+		PlayerID:  playerId,
+		ModeName:  modeName,
+		Reason:    reason,
+		IssuedBy:  issuedBy,
+		CreatedAt: now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		ban.ExpiresAt = &expiresAt
+	}
+	result, err := sanctionsCollection(collection).InsertOne(ctx, ban)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record ban: %w", err)
+	}
+	ban.ID = result.InsertedID.(primitive.ObjectID)
+
+	filter := bson.M{"players": playerId}
+	if modeName != "" {
+		filter["mode_name"] = modeName
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"mode_name": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the player's modes: %w", err)
+	}
+	var modes []ModeUsage
+	if err := cursor.All(ctx, &modes); err != nil {
+		return nil, fmt.Errorf("failed to decode modes: %w", err)
+	}
+	for _, mode := range modes {
+		if _, err := removeFromMode(ctx, collection, redisCache, mode.ModeName, playerId, now); err != nil {
+			return nil, fmt.Errorf("failed to remove player from %s: %w", mode.ModeName, err)
+		}
+	}
+
+	// Banned players may not watch either
+	filter = bson.M{"spectators": playerId}
+	if modeName != "" {
+		filter["mode_name"] = modeName
+	}
+	cursor, err = collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"mode_name": 1, "area_code": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the modes the player watches: %w", err)
+	}
+	var watched []ModeUsage
+	if err := cursor.All(ctx, &watched); err != nil {
+		return nil, fmt.Errorf("failed to decode modes: %w", err)
+	}
+	for _, mode := range watched {
+		update := bson.M{
+			"$inc":  bson.M{"spectator_count": -1},
+			"$pull": bson.M{"spectators": playerId},
+			"$set":  bson.M{"last_updated": now},
+		}
+		result, err := collection.UpdateOne(ctx, bson.M{"mode_name": mode.ModeName, "spectators": playerId}, update)
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove spectator from %s: %w", mode.ModeName, err)
+		}
+		if result.ModifiedCount > 0 {
+			invalidateSpectatorCaches(ctx, collection, redisCache, mode.ModeName, mode.AreaCode)
+		}
+	}
+	return ban, nil
+}
+
+// MutePlayerLogic stops a player sending chat messages in one mode, or in every mode when modeName
+// is empty, for duration (forever when zero). Muted players stay in their modes.
+func MutePlayerLogic(ctx context.Context, collection *mongo.Collection, playerId, modeName string, duration time.Duration, reason, issuedBy string) (*Sanction, error) {
+	now := time.Now()
+	mute := &Sanction{
+		Type:      SanctionMute,
+		PlayerID:  playerId,
+		ModeName:  modeName,
+		Reason:    reason,
+		IssuedBy:  issuedBy,
+		CreatedAt: now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		mute.ExpiresAt = &expiresAt
+	}
+	result, err := sanctionsCollection(collection).InsertOne(ctx, mute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record mute: %w", err)
+	}
+	mute.ID = result.InsertedID.(primitive.ObjectID)
+	return mute, nil
+}
+
+// ListSanctionsLogic returns sanctions matching filter, newest first.
+func ListSanctionsLogic(ctx context.Context, collection *mongo.Collection, filter SanctionFilter, now time.Time) ([]Sanction, error) {
+	query := bson.M{}
+	if filter.PlayerID != "" {
+		query["player_id"] = filter.PlayerID
+	}
+	if filter.ModeName != "" {
+		query["mode_name"] = filter.ModeName
+	}
+	if !filter.IncludeExpired {
+		// Only bans and mutes still in force; kicks are one-off and never in force
+		query["type"] = bson.M{"$in": bson.A{SanctionBan, SanctionMute}}
+		query["$or"] = notExpired(now)
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(filter.Limit)
+	cursor, err := sanctionsCollection(collection).Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sanctions: %w", err)
+	}
+	var sanctions []Sanction
+	if err := cursor.All(ctx, &sanctions); err != nil {
+		return nil, fmt.Errorf("failed to decode sanctions: %w", err)
+	}
+	return sanctions, nil
+}
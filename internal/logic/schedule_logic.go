@@ -0,0 +1,236 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// Game states the scheduler applies when a schedule does not name its own.
+	defaultOpenState   = "active"
+	defaultClosedState = "closed"
+
+	clockLayout = "15:04"
+)
+
+// ModeSchedule limits when a mode can be joined. A mode is available between StartsAt and EndsAt
+// (either may be unset) and, when Weekly is not empty, only inside one of its weekly windows,
+// evaluated in TimeZone (an IANA name, UTC when empty). A mode without a schedule is always available.
+type ModeSchedule struct {
+	StartsAt    *time.Time     `bson:"starts_at,omitempty"`
+	EndsAt      *time.Time     `bson:"ends_at,omitempty"`
+	TimeZone    string         `bson:"time_zone,omitempty"`
+	Weekly      []WeeklyWindow `bson:"weekly,omitempty"`
+	OpenState   string         `bson:"open_state,omitempty"`
+	ClosedState string         `bson:"closed_state,omitempty"`
+}
+
+// WeeklyWindow opens a mode every week on Weekday from Start to End, both "HH:MM" in the
+// schedule's time zone. An End not after Start closes the window on the following day.
+type WeeklyWindow struct {
+	Weekday time.Weekday `bson:"weekday"`
+	Start   string       `bson:"start"`
+	End     string       `bson:"end"`
+}
+
+// Validate reports the first problem that would keep the schedule from being evaluated.
+func (s *ModeSchedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return fmt.Errorf("invalid time zone %q: %w", s.TimeZone, err)
+	}
+	if s.StartsAt != nil && s.EndsAt != nil && !s.StartsAt.Before(*s.EndsAt) {
+		return fmt.Errorf("starts_at must be before ends_at")
+	}
+	for _, window := range s.Weekly {
+		if window.Weekday < time.Sunday || window.Weekday > time.Saturday {
+			return fmt.Errorf("invalid weekday %d", window.Weekday)
+		}
+		if _, err := time.Parse(clockLayout, window.Start); err != nil {
+			return fmt.Errorf("invalid window start %q, expected HH:MM", window.Start)
+		}
+		if _, err := time.Parse(clockLayout, window.End); err != nil {
+			return fmt.Errorf("invalid window end %q, expected HH:MM", window.End)
+		}
+	}
+	return nil
+}
+
+// AvailableAt reports whether the mode can be joined at t.
+func (s *ModeSchedule) AvailableAt(t time.Time) (bool, error) {
+	if s == nil {
+		return true, nil
+	}
+	if s.StartsAt != nil && t.Before(*s.StartsAt) {
+		return false, nil
+	}
+	if s.EndsAt != nil && !t.Before(*s.EndsAt) {
+		return false, nil
+	}
+	if len(s.Weekly) == 0 {
+		return true, nil
+	}
+
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return false, fmt.Errorf("invalid time zone %q: %w", s.TimeZone, err)
+	}
+	local := t.In(loc)
+	for _, window := range s.Weekly {
+		start, err := time.Parse(clockLayout, window.Start)
+		if err != nil {
+			return false, fmt.Errorf("invalid window start %q: %w", window.Start, err)
+		}
+		end, err := time.Parse(clockLayout, window.End)
+		if err != nil {
+			return false, fmt.Errorf("invalid window end %q: %w", window.End, err)
+		}
+		// A window that wraps past midnight may have opened yesterday
+		for _, offset := range []int{0, -1} {
+			day := local.AddDate(0, 0, offset)
+			if day.Weekday() != window.Weekday {
+				continue
+			}
+			opens := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+			closes := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+			if !closes.After(opens) {
+				closes = closes.AddDate(0, 0, 1)
+			}
+			if !local.Before(opens) && local.Before(closes) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (s *ModeSchedule) openState() string {
+	if s.OpenState != "" {
+		return s.OpenState
+	}
+	return defaultOpenState
+}
+
+func (s *ModeSchedule) closedState() string {
+	if s.ClosedState != "" {
+		return s.ClosedState
+	}
+	return defaultClosedState
+}
+
+// modeAvailable evaluates a mode's schedule, treating a schedule that cannot be evaluated as
+// closed so that a bad time zone never opens a mode by accident.
+func modeAvailable(ctx context.Context, mode ModeUsage, now time.Time) bool {
+	available, err := mode.Schedule.AvailableAt(now)
+	if err != nil {
+		slog.WarnContext(ctx, "Invalid mode schedule", "mode_name", mode.ModeName, "error", err)
+		return false
+	}
+	return available
+}
+
+// checkModeJoinable returns ErrModeNotFound, a *ModeDrainingError or ErrModeUnavailable when the
+// mode cannot be joined at now.
+func checkModeJoinable(ctx context.Context, collection *mongo.Collection, modeName string, now time.Time) error {
+	var mode ModeUsage
+	opts := options.FindOne().SetProjection(bson.M{"mode_name": 1, "schedule": 1, "drain": 1})
+	err := collection.FindOne(ctx, bson.M{"mode_name": modeName}, opts).Decode(&mode)
+	if err == mongo.ErrNoDocuments {
+		return ErrModeNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if mode.Drain != nil {
+		return &ModeDrainingError{RetryAfter: mode.Drain.RetryAfter}
+	}
+	if !modeAvailable(ctx, mode, now) {
+		return ErrModeUnavailable
+	}
+	return nil
+}
+
+// SetModeScheduleLogic replaces the schedule of a mode; a nil schedule makes it always available.
+func SetModeScheduleLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName string, schedule *ModeSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"schedule": schedule, "last_updated": time.Now()},
+		"$unset": bson.M{"schedule_open": ""},
+	}
+	if schedule == nil {
+		update = bson.M{
+			"$set":   bson.M{"last_updated": time.Now()},
+			"$unset": bson.M{"schedule": "", "schedule_open": ""},
+		}
+	}
+	result, err := collection.UpdateOne(ctx, bson.M{"mode_name": modeName}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrModeNotFound
+	}
+
+	redisCache.Delete(ctx, "mode_usage")
+	return nil
+}
+
+// ApplyModeSchedulesLogic moves every scheduled mode whose availability changed since the last run
+// into its open or closed game state. Only transitions are applied, so a game state set by hand
+// inside a window is left alone until the next boundary.
+func ApplyModeSchedulesLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, now time.Time) error {
+	cursor, err := collection.Find(ctx, bson.M{"schedule": bson.M{"$exists": true}})
+	if err != nil {
+		return fmt.Errorf("failed to query scheduled modes: %w", err)
+	}
+	var modes []ModeUsage
+	if err := cursor.All(ctx, &modes); err != nil {
+		return fmt.Errorf("failed to decode scheduled modes: %w", err)
+	}
+
+	changed := false
+	for _, mode := range modes {
+		available := modeAvailable(ctx, mode, now)
+		if mode.ScheduleOpen != nil && *mode.ScheduleOpen == available {
+			continue
+		}
+
+		// Only the instance that flips schedule_open applies the transition
+		filter := bson.M{"mode_name": mode.ModeName, "schedule_open": mode.ScheduleOpen}
+		result, err := collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"schedule_open": available}})
+		if err != nil {
+			return fmt.Errorf("failed to update schedule of %s: %w", mode.ModeName, err)
+		}
+		if result.ModifiedCount == 0 {
+			continue
+		}
+		changed = true
+
+		state := mode.Schedule.closedState()
+		if available {
+			state = mode.Schedule.openState()
+		}
+		if _, err := PatchGameStateLogic(ctx, collection, redisCache, mode.ModeName, GameStatePatch{Status: state, UpdatedBy: "mode-scheduler"}); err != nil {
+			return fmt.Errorf("failed to update game state of %s: %w", mode.ModeName, err)
+		}
+		slog.InfoContext(ctx, "Applied mode schedule", "mode_name", mode.ModeName, "available", available, "game_state", state)
+	}
+
+	if changed {
+		redisCache.Delete(ctx, "mode_usage")
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	seasonsCollectionName       = "seasons"
+	seasonRecordsCollectionName = "season_records"
+	seasonArchiveCollectionName = "season_standings_archive"
+)
+
+// Season is a time-boxed competition over some modes. An empty Modes list covers every mode.
+// Once a season has ended its standings are archived and ArchivedAt is set.
+type Season struct {
+	SeasonID   string     `bson:"season_id"`
+	Name       string     `bson:"name"`
+	Modes      []string   `bson:"modes"`
+	StartsAt   time.Time  `bson:"starts_at"`
+	EndsAt     time.Time  `bson:"ends_at"`
+	ArchivedAt *time.Time `bson:"archived_at"`
+}
+
+// covers reports whether the season applies to the mode.
+func (s Season) covers(modeName string) bool {
+	if len(s.Modes) == 0 {
+		return true
+	}
+	for _, mode := range s.Modes {
+		if mode == modeName {
+			return true
+		}
+	}
+	return false
+}
+
+// SeasonRecord is a player's accumulated results in one mode during one season. Rank is only
+// set on standings, where it is the 1-based position ordered by points, then wins, then losses.
+type SeasonRecord struct {
+	SeasonID string `bson:"season_id"`
+	ModeName string `bson:"mode_name"`
+	PlayerID string `bson:"player_id"`
+	Wins     int64  `bson:"wins"`
+	Losses   int64  `bson:"losses"`
+	Points   int64  `bson:"points"`
+	Rank     int64  `bson:"rank,omitempty"`
+}
+
+// MatchResult is one player's outcome of a match reported to RecordMatchOutcomeLogic.
+type MatchResult struct {
+	PlayerID string
+	Won      bool
+	Points   int64
+}
+
+// standingsSort orders season records from best to worst.
+var standingsSort = bson.D{{Key: "points", Value: -1}, {Key: "wins", Value: -1}, {Key: "losses", Value: 1}, {Key: "player_id", Value: 1}}
+
+func seasonsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, seasonsCollectionName)
+}
+
+func seasonRecordsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, seasonRecordsCollectionName)
+}
+
+func seasonArchiveCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, seasonArchiveCollectionName)
+}
+
+// CreateSeasonLogic stores a new season definition. Seasons covering the same mode may not overlap.
+func CreateSeasonLogic(ctx context.Context, collection *mongo.Collection, season Season) error {
+	overlap := bson.M{
+		"starts_at": bson.M{"$lt": season.EndsAt},
+		"ends_at":   bson.M{"$gt": season.StartsAt},
+	}
+	if len(season.Modes) > 0 {
+		overlap["$or"] = bson.A{bson.M{"modes": bson.M{"$in": season.Modes}}, bson.M{"modes": bson.M{"$size": 0}}}
+	}
+	count, err := seasonsCollection(collection).CountDocuments(ctx, overlap, options.Count().SetLimit(1))
+	if err != nil {
+		return fmt.Errorf("failed to check overlapping seasons: %w", err)
+	}
+	if count > 0 {
+		return ErrSeasonOverlap
+	}
+
+	season.ArchivedAt = nil
+	if season.Modes == nil {
+		season.Modes = []string{}
+	}
+	if _, err := seasonsCollection(collection).InsertOne(ctx, season); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrSeasonExists
+		}
+		return fmt.Errorf("failed to store season: %w", err)
+	}
+	return nil
+}
+
+// ListSeasonsLogic returns the seasons covering the mode, most recent first. An empty modeName
+// returns every season.
+func ListSeasonsLogic(ctx context.Context, collection *mongo.Collection, modeName string) ([]Season, error) {
+	filter := bson.M{}
+	if modeName != "" {
+		filter["$or"] = bson.A{bson.M{"modes": modeName}, bson.M{"modes": bson.M{"$size": 0}}}
+	}
+	cursor, err := seasonsCollection(collection).Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "starts_at", Value: -1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query seasons: %w", err)
+	}
+	var seasons []Season
+	if err := cursor.All(ctx, &seasons); err != nil {
+		return nil, fmt.Errorf("failed to decode seasons: %w", err)
+	}
+	return seasons, nil
+}
+
+// resolveSeason returns the season with the given ID, or the season covering the mode at now when
+// seasonID is empty.
+func resolveSeason(ctx context.Context, collection *mongo.Collection, modeName, seasonID string, now time.Time) (*Season, error) {
+	filter := bson.M{"season_id": seasonID}
+	if seasonID == "" {
+		filter = bson.M{
+			"starts_at": bson.M{"$lte": now},
+			"ends_at":   bson.M{"$gt": now},
+			"$or":       bson.A{bson.M{"modes": modeName}, bson.M{"modes": bson.M{"$size": 0}}},
+		}
+	}
+
+	var season Season
+	err := seasonsCollection(collection).FindOne(ctx, filter).Decode(&season)
+	if err == mongo.ErrNoDocuments {
+		if seasonID == "" {
+			return nil, ErrNoActiveSeason
+		}
+		return nil, ErrSeasonNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query seasons: %w", err)
+	}
+	if !season.covers(modeName) {
+		return nil, ErrSeasonNotFound
+	}
+	return &season, nil
+}
+
+// RecordMatchOutcomeLogic adds the results of one match in a mode to the players' season records.
+// Without a seasonID the season currently covering the mode is used. It returns that season's ID.
+func RecordMatchOutcomeLogic(ctx context.Context, collection *mongo.Collection, modeName, seasonID string, results []MatchResult, now time.Time) (string, error) {
+	season, err := resolveSeason(ctx, collection, modeName, seasonID, now)
+	if err != nil {
+		return "", err
+	}
+	if now.Before(season.StartsAt) || !now.Before(season.EndsAt) || season.ArchivedAt != nil {
+		return "", ErrSeasonClosed
+	}
+
+	models := make([]mongo.WriteModel, 0, len(results))
+	for _, result := range results {
+		inc := bson.M{"points": result.Points, "wins": 0, "losses": 0}
+		if result.Won {
+			inc["wins"] = 1
+		} else {
+			inc["losses"] = 1
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"season_id": season.SeasonID, "mode_name": modeName, "player_id": result.PlayerID}).
+			SetUpdate(bson.M{"$inc": inc, "$set": bson.M{"updated_at": now}}).
+			SetUpsert(true))
+	}
+	if _, err := seasonRecordsCollection(collection).BulkWrite(ctx, models); err != nil {
+		return "", fmt.Errorf("failed to record match outcome: %w", err)
+	}
+	return season.SeasonID, nil
+}
+
+// GetSeasonStandingsLogic returns the best limit records of a mode in a season. Without a seasonID
+// the current season is used; the standings of archived seasons are read from the archive.
+func GetSeasonStandingsLogic(ctx context.Context, collection *mongo.Collection, modeName, seasonID string, limit int64, now time.Time) (*Season, []SeasonRecord, error) {
+	season, err := resolveSeason(ctx, collection, modeName, seasonID, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source := seasonRecordsCollection(collection)
+	if season.ArchivedAt != nil {
+		source = seasonArchiveCollection(collection)
+	}
+	opts := options.Find().SetSort(standingsSort).SetLimit(limit)
+	cursor, err := source.Find(ctx, bson.M{"season_id": season.SeasonID, "mode_name": modeName}, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query standings: %w", err)
+	}
+	var records []SeasonRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode standings: %w", err)
+	}
+	for i := range records {
+		records[i].Rank = int64(i) + 1
+	}
+	return season, records, nil
+}
+
+// ArchiveEndedSeasonsLogic freezes the final standings of every season that ended before now into
+// the archive and removes its live records, so the next season starts from a clean slate. Ended
+// seasons are marked archived first, which stops RecordMatchOutcomeLogic from accepting results for
+// them; every archived season with live records left is then archived, so a run interrupted
+// half-way, or a result recorded while the season was being marked, is completed by the next run.
+func ArchiveEndedSeasonsLogic(ctx context.Context, collection *mongo.Collection, now time.Time) error {
+	ended := bson.M{"ends_at": bson.M{"$lte": now}, "archived_at": nil}
+	if _, err := seasonsCollection(collection).UpdateMany(ctx, ended, bson.M{"$set": bson.M{"archived_at": now}}); err != nil {
+		return fmt.Errorf("failed to mark ended seasons: %w", err)
+	}
+
+	live, err := seasonRecordsCollection(collection).Distinct(ctx, "season_id", bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to query seasons with records: %w", err)
+	}
+	if len(live) == 0 {
+		return nil
+	}
+	cursor, err := seasonsCollection(collection).Find(ctx, bson.M{"season_id": bson.M{"$in": live}, "archived_at": bson.M{"$ne": nil}})
+	if err != nil {
+		return fmt.Errorf("failed to query archived seasons: %w", err)
+	}
+	var archived []Season
+	if err := cursor.All(ctx, &archived); err != nil {
+		return fmt.Errorf("failed to decode seasons: %w", err)
+	}
+
+	for _, season := range archived {
+		if err := archiveSeason(ctx, collection, season.SeasonID); err != nil {
+			return fmt.Errorf("failed to archive season %s: %w", season.SeasonID, err)
+		}
+	}
+	return nil
+}
+
+// archiveSeason moves the live records of a season into the archive and ranks the archived
+// standings again. Only records that are unchanged since they were read are deleted.
+func archiveSeason(ctx context.Context, collection *mongo.Collection, seasonID string) error {
+	filter := bson.M{"season_id": seasonID}
+	cursor, err := seasonRecordsCollection(collection).Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	var live []SeasonRecord
+	if err := cursor.All(ctx, &live); err != nil {
+		return err
+	}
+	cursor, err = seasonArchiveCollection(collection).Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	var records []SeasonRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return err
+	}
+
+	// Live records replace what an earlier run archived for the same player
+	type playerKey struct{ mode, player string }
+	index := make(map[playerKey]int, len(records))
+	for i, record := range records {
+		index[playerKey{record.ModeName, record.PlayerID}] = i
+	}
+	for _, record := range live {
+		if i, ok := index[playerKey{record.ModeName, record.PlayerID}]; ok {
+			records[i] = record
+		} else {
+			records = append(records, record)
+		}
+	}
+	// The order of standingsSort, per mode
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.ModeName != b.ModeName {
+			return a.ModeName < b.ModeName
+		}
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.Losses != b.Losses {
+			return a.Losses < b.Losses
+		}
+		return a.PlayerID < b.PlayerID
+	})
+
+	var models []mongo.WriteModel
+	rank, mode := int64(0), ""
+	for _, record := range records {
+		if record.ModeName != mode {
+			rank, mode = 0, record.ModeName
+		}
+		rank++
+		record.Rank = rank
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"season_id": seasonID, "mode_name": record.ModeName, "player_id": record.PlayerID}).
+			SetReplacement(record).
+			SetUpsert(true))
+	}
+	if len(models) > 0 {
+		if _, err := seasonArchiveCollection(collection).BulkWrite(ctx, models); err != nil {
+			return err
+		}
+	}
+
+	models = models[:0]
+	for _, record := range live {
+		models = append(models, mongo.NewDeleteOneModel().SetFilter(bson.M{
+			"season_id": seasonID,
+			"mode_name": record.ModeName,
+			"player_id": record.PlayerID,
+			"wins":      record.Wins,
+			"losses":    record.Losses,
+			"points":    record.Points,
+		}))
+	}
+	if len(models) > 0 {
+		if _, err := seasonRecordsCollection(collection).BulkWrite(ctx, models); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	sessionsCollectionName = "sessions"
+
+	// SessionEndLeft records a session closed by LeaveModeLogic.
+	SessionEndLeft = "left"
+	// SessionEndEvicted records a session closed by EvictStaleSessionsLogic because its player was
+	// no longer in the mode.
+	SessionEndEvicted = "evicted"
+
+	// SessionGroupByMode and SessionGroupByArea select how GetSessionStatsLogic groups sessions.
+	SessionGroupByMode = "mode_name"
+	SessionGroupByArea = "area_code"
+)
+
+// PlayerSession is one stay of a player in a mode. LeftAt is nil while the player is still in the mode.
+type PlayerSession struct {
+	ModeName        string     `bson:"mode_name"`
+	AreaCode        string     `bson:"area_code"`
+	PlayerID        string     `bson:"player_id"`
+	JoinedAt        time.Time  `bson:"joined_at"`
+	LeftAt          *time.Time `bson:"left_at"`
+	DurationSeconds float64    `bson:"duration_seconds"`
+	EndReason       string     `bson:"end_reason,omitempty"`
+}
+
+// SessionStats summarises the length of the sessions of one mode or area.
+type SessionStats struct {
+	Key            string
+	SessionCount   int
+	AverageSeconds float64
+	MedianSeconds  float64
+}
+
+func sessionsCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, sessionsCollectionName)
+}
+
+// startSession opens a session for a player who just joined. Failures are logged rather than
+// returned because the join itself has already been committed.
+func startSession(ctx context.Context, collection *mongo.Collection, modeName, areaCode, playerId string, at time.Time) {
+	_, err := sessionsCollection(collection).InsertOne(ctx, PlayerSession{
+		ModeName: modeName,
+		AreaCode: areaCode,
+		PlayerID: playerId,
+		JoinedAt: at,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to record session start", "mode_name", modeName, "player_id", playerId, "error", err)
+	}
+}
+
+// endSession closes the player's most recent open session in the mode, recording its duration
+// and why it ended. Like startSession it only logs failures.
+func endSession(ctx context.Context, collection *mongo.Collection, modeName, playerId, reason string, at time.Time) {
+	filter := bson.M{"mode_name": modeName, "player_id": playerId, "left_at": nil}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "joined_at", Value: -1}})
+
+	err := sessionsCollection(collection).FindOneAndUpdate(ctx, filter, sessionEnd(reason, at), opts).Err()
+	if err != nil && err != mongo.ErrNoDocuments {
+		slog.WarnContext(ctx, "Failed to record session end", "mode_name", modeName, "player_id", playerId, "error", err)
+	}
+}
+
+// sessionEnd is the update that closes a session at at for reason.
+func sessionEnd(reason string, at time.Time) mongo.Pipeline {
+	return mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"left_at":          at,
+		"end_reason":       reason,
+		"duration_seconds": bson.M{"$divide": bson.A{bson.M{"$subtract": bson.A{at, "$joined_at"}}, 1000}},
+	}}}}
+}
+
+// EvictStaleSessionsLogic closes the open sessions whose player is no longer in the mode, with
+// SessionEndEvicted, and returns how many were closed. Such sessions are left behind when a mode is
+// removed, or when closing a session failed after its player left.
+func EvictStaleSessionsLogic(ctx context.Context, collection *mongo.Collection, now time.Time) (int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"left_at": nil}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from": collection.Name(),
+			"let":  bson.M{"mode": "$mode_name", "player": "$player_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$mode_name", "$$mode"}},
+					bson.M{"$in": bson.A{"$$player", bson.M{"$ifNull": bson.A{"$players", bson.A{}}}}},
+				}}}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": "present",
+		}}},
+		{{Key: "$match", Value: bson.M{"present": bson.M{"$size": 0}}}},
+		{{Key: "$project", Value: bson.M{"_id": 1}}},
+	}
+	cursor, err := sessionsCollection(collection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find stale sessions: %w", err)
+	}
+	var stale []struct {
+		ID interface{} `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &stale); err != nil {
+		return 0, fmt.Errorf("failed to decode stale sessions: %w", err)
+	}
+	if len(stale) == 0 {
+		return 0, nil
+	}
+
+	ids := make(bson.A, 0, len(stale))
+	for _, session := range stale {
+		ids = append(ids, session.ID)
+	}
+	// A session closed by a leave in the meantime keeps its reason
+	filter := bson.M{"_id": bson.M{"$in": ids}, "left_at": nil}
+	result, err := sessionsCollection(collection).UpdateMany(ctx, filter, sessionEnd(SessionEndEvicted, now))
+	if err != nil {
+		return 0, fmt.Errorf("failed to evict stale sessions: %w", err)
+	}
+	return result.ModifiedCount, nil
+}
+
+// GetSessionStatsLogic returns the average and median length of the sessions that ended between
+// from and to, grouped by mode or by area code. When key is set only that mode or area is returned;
+// an area with child regions includes the sessions of every area below it.
+func GetSessionStatsLogic(ctx context.Context, collection *mongo.Collection, groupBy, key string, from, to time.Time) ([]SessionStats, error) {
+	filter := bson.M{"left_at": bson.M{"$gte": from, "$lt": to}}
+	rollUp := false
+	if key != "" {
+		filter[groupBy] = key
+		if groupBy == SessionGroupByArea {
+			// A region covers the sessions of every area below it
+			regions, err := loadRegions(ctx, collection)
+			if err != nil {
+				return nil, err
+			}
+			if areas := newAreaHierarchy(regions).subtree(key); len(areas) > 1 {
+				filter[groupBy] = bson.M{"$in": areas}
+				rollUp = true
+			}
+		}
+	}
+	cursor, err := sessionsCollection(collection).Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	durations := map[string][]float64{}
+	for cursor.Next(ctx) {
+		var session PlayerSession
+		if err := cursor.Decode(&session); err != nil {
+			return nil, fmt.Errorf("failed to decode session: %w", err)
+		}
+		group := session.ModeName
+		if groupBy == SessionGroupByArea {
+			group = session.AreaCode
+		}
+		if rollUp {
+			group = key
+		}
+		durations[group] = append(durations[group], session.DurationSeconds)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	stats := make([]SessionStats, 0, len(durations))
+	for group, values := range durations {
+		stats = append(stats, summariseDurations(group, values))
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Key < stats[j].Key })
+	return stats, nil
+}
+
+func summariseDurations(key string, values []float64) SessionStats {
+	sort.Float64s(values)
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = (values[len(values)/2-1] + values[len(values)/2]) / 2
+	}
+
+	return SessionStats{
+		Key:            key,
+		SessionCount:   len(values),
+		AverageSeconds: total / float64(len(values)),
+		MedianSeconds:  median,
+	}
+}
@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/config"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// invalidateSpectatorCaches drops the cached results that include spectator counts of a mode in areaCode.
+func invalidateSpectatorCaches(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, areaCode string) {
+	redisCache.Delete(ctx, "mode_details:"+modeName)
+	invalidateAreaStats(ctx, redisCache, areaWithAncestors(ctx, collection, redisCache, areaCode)...)
+	redisCache.Delete(ctx, "mode_usage")
+	redisCache.Delete(ctx, "game_mode_stats")
+}
+
+// JoinAsSpectatorLogic adds a spectator to a mode. Spectators do not take a player slot and are
+// not counted in active_users; they are limited by the spectator capacity instead. Joining again
+// while already spectating is a no-op.
+func JoinAsSpectatorLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, spectatorId string) error {
+	if err := checkNotBanned(ctx, collection, modeName, spectatorId, time.Now()); err != nil {
+		return err
+	}
+
+	filter := bson.M{"mode_name": modeName, "spectators": bson.M{"$ne": spectatorId}}
+	if capacity := config.Runtime().SpectatorCapacity(modeName); capacity > 0 {
+		filter["spectator_count"] = bson.M{"$lt": capacity}
+	}
+	update := bson.M{
+		"$inc":  bson.M{"spectator_count": 1},
+		"$push": bson.M{"spectators": spectatorId},
+		"$set":  bson.M{"last_updated": time.Now()},
+	}
+	var joined ModeUsage
+	opts := options.FindOneAndUpdate().SetProjection(bson.M{"area_code": 1})
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&joined)
+	if err == mongo.ErrNoDocuments {
+		// Nothing matched: the mode does not exist, the spectator is already in it, or it is full
+		var mode ModeUsage
+		opts := options.FindOne().SetProjection(bson.M{"spectators": bson.M{"$elemMatch": bson.M{"$eq": spectatorId}}})
+		err := collection.FindOne(ctx, bson.M{"mode_name": modeName}, opts).Decode(&mode)
+		if err == mongo.ErrNoDocuments {
+			return ErrModeNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if len(mode.Spectators) > 0 {
+			return nil
+		}
+		return ErrSpectatorsFull
+	}
+	if err != nil {
+		return err
+	}
+
+	invalidateSpectatorCaches(ctx, collection, redisCache, modeName, joined.AreaCode)
+	return nil
+}
+
+// LeaveSpectatorLogic removes a spectator from a mode. Leaving a mode that is not being watched is a no-op.
+func LeaveSpectatorLogic(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, spectatorId string) error {
+	update := bson.M{
+		"$inc":  bson.M{"spectator_count": -1},
+		"$pull": bson.M{"spectators": spectatorId},
+		"$set":  bson.M{"last_updated": time.Now()},
+	}
+	var left ModeUsage
+	opts := options.FindOneAndUpdate().SetProjection(bson.M{"area_code": 1})
+	err := collection.FindOneAndUpdate(ctx, bson.M{"mode_name": modeName, "spectators": spectatorId}, update, opts).Decode(&left)
+	if err == mongo.ErrNoDocuments {
+		exists, err := collection.CountDocuments(ctx, bson.M{"mode_name": modeName}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+		if exists == 0 {
+			return ErrModeNotFound
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	invalidateSpectatorCaches(ctx, collection, redisCache, modeName, left.AreaCode)
+	return nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// UniquePeriodDay and UniquePeriodMonth select the window GetUniquePlayersLogic counts over.
+	UniquePeriodDay   = "day"
+	UniquePeriodMonth = "month"
+
+	// Counters are kept a little longer than their period so the previous day or month stays queryable.
+	dailyUniquesTTL   = 35 * 24 * time.Hour
+	monthlyUniquesTTL = 400 * 24 * time.Hour
+)
+
+// uniquePlayersKey names the HyperLogLog of one mode (or one area code when mode is empty) for
+// the day or month containing at, in UTC.
+func uniquePlayersKey(mode, areaCode, period string, at time.Time) string {
+	bucket := at.UTC().Format("2006-01-02")
+	if period == UniquePeriodMonth {
+		bucket = at.UTC().Format("2006-01")
+	}
+	if mode == "" {
+		return "unique_players:area:" + areaCode + ":" + period + ":" + bucket
+	}
+	key := "unique_players:mode:" + mode
+	if areaCode != "" {
+		key += ":area:" + areaCode
+	}
+	return key + ":" + period + ":" + bucket
+}
+
+// recordUniquePlayer counts a player towards the daily and monthly unique players of the mode,
+// of the mode within its area and of the area as a whole. Area counts roll up into every parent
+// region, so any level of the hierarchy can be queried.
+func recordUniquePlayer(ctx context.Context, collection *mongo.Collection, redisCache *cache.RedisCache, modeName, areaCode, playerId string, at time.Time) {
+	var areas []string
+	if areaCode != "" {
+		areas = areaWithAncestors(ctx, collection, redisCache, areaCode)
+	}
+	for _, period := range []struct {
+		name string
+		ttl  time.Duration
+	}{{UniquePeriodDay, dailyUniquesTTL}, {UniquePeriodMonth, monthlyUniquesTTL}} {
+		redisCache.AddUnique(ctx, uniquePlayersKey(modeName, "", period.name, at), period.ttl, playerId)
+		for _, area := range areas {
+			redisCache.AddUnique(ctx, uniquePlayersKey(modeName, area, period.name, at), period.ttl, playerId)
+			redisCache.AddUnique(ctx, uniquePlayersKey("", area, period.name, at), period.ttl, playerId)
+		}
+	}
+}
+
+// GetUniquePlayersLogic returns the approximate number of distinct players that joined during the
+// day or month containing at. Either modeName, areaCode or both narrow down what is counted.
+func GetUniquePlayersLogic(ctx context.Context, redisCache *cache.RedisCache, modeName, areaCode, period string, at time.Time) int64 {
+	return redisCache.CountUnique(ctx, uniquePlayersKey(modeName, areaCode, period, at))
+}
@@ -0,0 +1,491 @@
+package logic
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	webhooksCollectionName          = "webhooks"
+	webhookDeliveriesCollectionName = "webhook_deliveries"
+
+	// WebhookEventModeStarted is sent when a mode's game state changes to the active status, and
+	// WebhookEventModeFull when a join takes the last seat of a mode with a capacity, counting the
+	// seats held by reservations. A player taking a seat reserved for them does not fill the mode,
+	// since the seat was already counted. Subscriptions can also ask for the raw outbox event types.
+	WebhookEventModeStarted = "mode_started"
+	WebhookEventModeFull    = "mode_full"
+
+	// WebhookDeliveryPending, WebhookDeliveryDelivered and WebhookDeliveryDead are the states of a
+	// delivery. Dead deliveries ran out of attempts and form the dead-letter list.
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+
+	// WebhookSignatureHeader carries "sha256=" followed by the hex HMAC-SHA256, keyed with the
+	// subscription secret, of the WebhookTimestampHeader value, a dot and the request body.
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+
+	webhookDispatcherName = "webhooks"
+)
+
+var webhookEventTypes = map[string]bool{
+	WebhookEventModeStarted:     true,
+	WebhookEventModeFull:        true,
+	OutboxEventPlayerJoined:     true,
+	OutboxEventPlayerLeft:       true,
+	OutboxEventGameStateChanged: true,
+}
+
+// Webhook is a subscription to the events of one mode, or of every mode when ModeName is empty.
+// Events lists the event types to send; an empty list sends all of them.
+type Webhook struct {
+	WebhookID string    `bson:"webhook_id"`
+	URL       string    `bson:"url"`
+	Secret    string    `bson:"secret"`
+	ModeName  string    `bson:"mode_name,omitempty"`
+	Events    []string  `bson:"events,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+	CreatedBy string    `bson:"created_by,omitempty"`
+}
+
+func (w Webhook) matches(eventType, modeName string) bool {
+	if w.ModeName != "" && w.ModeName != modeName {
+		return false
+	}
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, event := range w.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookAttempt is one entry of a delivery's log. StatusCode is 0 when no response was received.
+type WebhookAttempt struct {
+	At         time.Time `bson:"at"`
+	StatusCode int       `bson:"status_code,omitempty"`
+	Error      string    `bson:"error,omitempty"`
+}
+
+// WebhookDelivery is one event on its way to one webhook.
+type WebhookDelivery struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	WebhookID     string             `bson:"webhook_id"`
+	EventID       string             `bson:"event_id"`
+	EventType     string             `bson:"event_type"`
+	ModeName      string             `bson:"mode_name"`
+	Payload       string             `bson:"payload"`
+	Status        string             `bson:"status"`
+	Attempts      int                `bson:"attempts"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	DeliveredAt   *time.Time         `bson:"delivered_at,omitempty"`
+	AttemptLog    []WebhookAttempt   `bson:"attempt_log"`
+}
+
+// webhookPayload is the JSON body of a delivery. Receivers can drop duplicates by event_id and type.
+type webhookPayload struct {
+	EventID  string                 `json:"event_id"`
+	Type     string                 `json:"type"`
+	ModeName string                 `json:"mode_name"`
+	PlayerID string                 `json:"player_id,omitempty"`
+	Data     map[string]interface{} `json:"data,omitempty"`
+	At       time.Time              `json:"at"`
+}
+
+// WebhookRetryPolicy decides when failed deliveries are tried again. The delay doubles after
+// every failed attempt, from BaseDelay up to MaxDelay, and a delivery that failed MaxAttempts
+// times is moved to the dead-letter list.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Backoff returns how long to wait after the given number of failed attempts.
+func (p WebhookRetryPolicy) Backoff(failures int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+func webhooksCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, webhooksCollectionName)
+}
+
+func webhookDeliveriesCollection(modes *mongo.Collection) *mongo.Collection {
+	return sibling(modes, webhookDeliveriesCollectionName)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SignWebhookPayload returns the WebhookSignatureHeader value for body sent at timestamp.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreateWebhookLogic registers a webhook. A secret is generated when none is given; the returned
+// webhook is the only place it is shown.
+func CreateWebhookLogic(ctx context.Context, collection *mongo.Collection, webhook Webhook) (*Webhook, error) {
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+	for _, event := range webhook.Events {
+		if !webhookEventTypes[event] {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, event)
+		}
+	}
+
+	if webhook.WebhookID, err = randomHex(8); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook id: %w", err)
+	}
+	if webhook.Secret == "" {
+		if webhook.Secret, err = randomHex(32); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+	}
+	webhook.CreatedAt = time.Now()
+	if _, err := webhooksCollection(collection).InsertOne(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to store webhook: %w", err)
+	}
+	return &webhook, nil
+}
+
+// DeleteWebhookLogic removes a webhook together with its deliveries.
+func DeleteWebhookLogic(ctx context.Context, collection *mongo.Collection, webhookID string) error {
+	result, err := webhooksCollection(collection).DeleteOne(ctx, bson.M{"webhook_id": webhookID})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrWebhookNotFound
+	}
+	if _, err := webhookDeliveriesCollection(collection).DeleteMany(ctx, bson.M{"webhook_id": webhookID}); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ListWebhooksLogic returns the webhooks that receive events of modeName, including those for
+// every mode, or all webhooks when modeName is empty.
+func ListWebhooksLogic(ctx context.Context, collection *mongo.Collection, modeName string) ([]Webhook, error) {
+	filter := bson.M{}
+	if modeName != "" {
+		filter["mode_name"] = bson.M{"$in": bson.A{modeName, "", nil}}
+	}
+	cursor, err := webhooksCollection(collection).Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+	var webhooks []Webhook
+	if err := cursor.All(ctx, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to decode webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func numberValue(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
+
+// webhookEventsFor returns the webhook event types an outbox event stands for: its own type, plus
+// mode_started or mode_full when it is one of those transitions.
+func webhookEventsFor(event OutboxEvent) []string {
+	types := []string{event.Type}
+	switch event.Type {
+	case OutboxEventPlayerJoined:
+		seats := numberValue(event.Data["active_users"]) + numberValue(event.Data["reserved"])
+		if capacity := numberValue(event.Data["capacity"]); capacity > 0 && seats == capacity && event.Data["reservation"] != true {
+			types = append(types, WebhookEventModeFull)
+		}
+	case OutboxEventGameStateChanged:
+		if event.Data["status"] == defaultOpenState && event.Data["previous_status"] != defaultOpenState {
+			types = append(types, WebhookEventModeStarted)
+		}
+	}
+	return types
+}
+
+// WebhookDispatcher is the outbox sink that turns change events into deliveries for every
+// matching webhook. Deliveries are unique per webhook, event and type, so events the relay sends
+// again are not delivered twice.
+type WebhookDispatcher struct {
+	collection *mongo.Collection
+}
+
+// NewWebhookDispatcher initializes a WebhookDispatcher storing deliveries next to collection.
+func NewWebhookDispatcher(collection *mongo.Collection) *WebhookDispatcher {
+	return &WebhookDispatcher{collection: collection}
+}
+
+func (d *WebhookDispatcher) Name() string {
+	return webhookDispatcherName
+}
+
+func (d *WebhookDispatcher) Publish(ctx context.Context, events []OutboxEvent) error {
+	webhooks, err := ListWebhooksLogic(ctx, d.collection, "")
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	var deliveries []interface{}
+	for _, event := range events {
+		for _, eventType := range webhookEventsFor(event) {
+			payload, err := json.Marshal(webhookPayload{
+				EventID:  event.ID.Hex(),
+				Type:     eventType,
+				ModeName: event.ModeName,
+				PlayerID: event.PlayerID,
+				Data:     event.Data,
+				At:       event.At,
+			})
+			if err != nil {
+				return err
+			}
+			for _, webhook := range webhooks {
+				if !webhook.matches(eventType, event.ModeName) {
+					continue
+				}
+				deliveries = append(deliveries, WebhookDelivery{
+					ID:            primitive.NewObjectID(),
+					WebhookID:     webhook.WebhookID,
+					EventID:       event.ID.Hex(),
+					EventType:     eventType,
+					ModeName:      event.ModeName,
+					Payload:       string(payload),
+					Status:        WebhookDeliveryPending,
+					NextAttemptAt: now,
+					CreatedAt:     now,
+					AttemptLog:    []WebhookAttempt{},
+				})
+			}
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	_, err = webhookDeliveriesCollection(d.collection).InsertMany(ctx, deliveries, options.InsertMany().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		for _, writeErr := range bulkErr.WriteErrors {
+			if !mongo.IsDuplicateKeyError(writeErr) {
+				return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+			}
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// sendWebhook POSTs a delivery to its webhook once and describes the outcome.
+func sendWebhook(ctx context.Context, client *http.Client, webhook Webhook, delivery WebhookDelivery) WebhookAttempt {
+	attempt := WebhookAttempt{At: time.Now()}
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(attempt.At.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.Hex())
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		attempt.Error = "unexpected status " + resp.Status
+	}
+	return attempt
+}
+
+// DeliverWebhooksLogic sends up to batchSize deliveries that are due at now and returns how many
+// succeeded. Failed deliveries are scheduled again following policy, or moved to the dead-letter
+// list once they run out of attempts. Each delivery is claimed before it is sent, so several
+// replicas can run this side by side.
+func DeliverWebhooksLogic(ctx context.Context, collection *mongo.Collection, client *http.Client, policy WebhookRetryPolicy, batchSize int64, now time.Time) (int, error) {
+	filter := bson.M{"status": WebhookDeliveryPending, "next_attempt_at": bson.M{"$lte": now}}
+	opts := options.Find().SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).SetLimit(batchSize)
+	cursor, err := webhookDeliveriesCollection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	var deliveries []WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return 0, fmt.Errorf("failed to decode webhook deliveries: %w", err)
+	}
+
+	// A delivery whose sender died is picked up again once the claim runs out
+	lease := time.Minute
+	if 2*client.Timeout > lease {
+		lease = 2 * client.Timeout
+	}
+	webhooks := make(map[string]*Webhook)
+	delivered := 0
+	for _, delivery := range deliveries {
+		claim := bson.M{"_id": delivery.ID, "status": WebhookDeliveryPending, "next_attempt_at": delivery.NextAttemptAt}
+		result, err := webhookDeliveriesCollection(collection).UpdateOne(ctx, claim, bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}})
+		if err != nil {
+			return delivered, fmt.Errorf("failed to claim webhook delivery: %w", err)
+		}
+		if result.ModifiedCount == 0 {
+			continue
+		}
+
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook = &Webhook{}
+			if err := webhooksCollection(collection).FindOne(ctx, bson.M{"webhook_id": delivery.WebhookID}).Decode(webhook); err != nil {
+				webhook = nil
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+
+		var attempt WebhookAttempt
+		if webhook == nil {
+			attempt = WebhookAttempt{At: time.Now(), Error: ErrWebhookNotFound.Error()}
+		} else {
+			attempt = sendWebhook(ctx, client, *webhook, delivery)
+		}
+
+		set := bson.M{}
+		switch {
+		case attempt.Error == "":
+			set["status"] = WebhookDeliveryDelivered
+			set["delivered_at"] = attempt.At
+			delivered++
+		case webhook == nil || delivery.Attempts+1 >= policy.MaxAttempts:
+			set["status"] = WebhookDeliveryDead
+		default:
+			set["next_attempt_at"] = now.Add(policy.Backoff(delivery.Attempts + 1))
+		}
+		update := bson.M{"$set": set, "$inc": bson.M{"attempts": 1}, "$push": bson.M{"attempt_log": attempt}}
+		if _, err := webhookDeliveriesCollection(collection).UpdateOne(ctx, bson.M{"_id": delivery.ID}, update); err != nil {
+			return delivered, fmt.Errorf("failed to record webhook delivery: %w", err)
+		}
+	}
+	return delivered, nil
+}
+
+// ListWebhookDeliveriesLogic returns the most recent deliveries, newest first, optionally only
+// those of one webhook and in one state. Listing the dead state gives the dead-letter list.
+func ListWebhookDeliveriesLogic(ctx context.Context, collection *mongo.Collection, webhookID, status string, limit int64) ([]WebhookDelivery, error) {
+	filter := bson.M{}
+	if webhookID != "" {
+		filter["webhook_id"] = webhookID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetLimit(limit)
+	cursor, err := webhookDeliveriesCollection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	var deliveries []WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// RetryWebhookDeliveryLogic moves a delivery from the dead-letter list back to the queue with a
+// fresh set of attempts. Its attempt log is kept.
+func RetryWebhookDeliveryLogic(ctx context.Context, collection *mongo.Collection, deliveryID string, now time.Time) (*WebhookDelivery, error) {
+	id, err := primitive.ObjectIDFromHex(deliveryID)
+	if err != nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	var delivery WebhookDelivery
+	update := bson.M{"$set": bson.M{"status": WebhookDeliveryPending, "attempts": 0, "next_attempt_at": now}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = webhookDeliveriesCollection(collection).FindOneAndUpdate(ctx, bson.M{"_id": id, "status": WebhookDeliveryDead}, update, opts).Decode(&delivery)
+	if err == mongo.ErrNoDocuments {
+		exists, countErr := webhookDeliveriesCollection(collection).CountDocuments(ctx, bson.M{"_id": id}, options.Count().SetLimit(1))
+		if countErr != nil {
+			return nil, countErr
+		}
+		if exists == 0 {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, ErrWebhookDeliveryNotDead
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// PruneWebhookDeliveriesLogic deletes the delivered and dead deliveries created before now minus retention.
+func PruneWebhookDeliveriesLogic(ctx context.Context, collection *mongo.Collection, retention time.Duration, now time.Time) error {
+	filter := bson.M{"status": bson.M{"$ne": WebhookDeliveryPending}, "created_at": bson.M{"$lt": now.Add(-retention)}}
+	if _, err := webhookDeliveriesCollection(collection).DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to prune webhook deliveries: %w", err)
+	}
+	return nil
+}
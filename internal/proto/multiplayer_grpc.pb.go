@@ -28,6 +28,47 @@ const (
 	MultiplayerService_LeaveMode_FullMethodName                = "/multiplayer.MultiplayerService/LeaveMode"
 	MultiplayerService_GetPlayers_FullMethodName               = "/multiplayer.MultiplayerService/GetPlayers"
 	MultiplayerService_UpdateGameState_FullMethodName          = "/multiplayer.MultiplayerService/UpdateGameState"
+	MultiplayerService_GetGameState_FullMethodName             = "/multiplayer.MultiplayerService/GetGameState"
+	MultiplayerService_GetGameStateHistory_FullMethodName      = "/multiplayer.MultiplayerService/GetGameStateHistory"
+	MultiplayerService_GetGameStateAt_FullMethodName           = "/multiplayer.MultiplayerService/GetGameStateAt"
+	MultiplayerService_GetActiveUsersHistory_FullMethodName    = "/multiplayer.MultiplayerService/GetActiveUsersHistory"
+	MultiplayerService_GetSessionStats_FullMethodName          = "/multiplayer.MultiplayerService/GetSessionStats"
+	MultiplayerService_GetUniquePlayers_FullMethodName         = "/multiplayer.MultiplayerService/GetUniquePlayers"
+	MultiplayerService_SubmitScore_FullMethodName              = "/multiplayer.MultiplayerService/SubmitScore"
+	MultiplayerService_GetTopN_FullMethodName                  = "/multiplayer.MultiplayerService/GetTopN"
+	MultiplayerService_GetPlayerRank_FullMethodName            = "/multiplayer.MultiplayerService/GetPlayerRank"
+	MultiplayerService_GetAroundPlayer_FullMethodName          = "/multiplayer.MultiplayerService/GetAroundPlayer"
+	MultiplayerService_CreateSeason_FullMethodName             = "/multiplayer.MultiplayerService/CreateSeason"
+	MultiplayerService_ListSeasons_FullMethodName              = "/multiplayer.MultiplayerService/ListSeasons"
+	MultiplayerService_RecordMatchOutcome_FullMethodName       = "/multiplayer.MultiplayerService/RecordMatchOutcome"
+	MultiplayerService_GetSeasonStandings_FullMethodName       = "/multiplayer.MultiplayerService/GetSeasonStandings"
+	MultiplayerService_SetModeSchedule_FullMethodName          = "/multiplayer.MultiplayerService/SetModeSchedule"
+	MultiplayerService_DrainMode_FullMethodName                = "/multiplayer.MultiplayerService/DrainMode"
+	MultiplayerService_ResumeMode_FullMethodName               = "/multiplayer.MultiplayerService/ResumeMode"
+	MultiplayerService_KickPlayer_FullMethodName               = "/multiplayer.MultiplayerService/KickPlayer"
+	MultiplayerService_BanPlayer_FullMethodName                = "/multiplayer.MultiplayerService/BanPlayer"
+	MultiplayerService_ListSanctions_FullMethodName            = "/multiplayer.MultiplayerService/ListSanctions"
+	MultiplayerService_MutePlayer_FullMethodName               = "/multiplayer.MultiplayerService/MutePlayer"
+	MultiplayerService_JoinAsSpectator_FullMethodName          = "/multiplayer.MultiplayerService/JoinAsSpectator"
+	MultiplayerService_LeaveSpectator_FullMethodName           = "/multiplayer.MultiplayerService/LeaveSpectator"
+	MultiplayerService_Chat_FullMethodName                     = "/multiplayer.MultiplayerService/Chat"
+	MultiplayerService_RegisterServer_FullMethodName           = "/multiplayer.MultiplayerService/RegisterServer"
+	MultiplayerService_ServerHeartbeat_FullMethodName          = "/multiplayer.MultiplayerService/ServerHeartbeat"
+	MultiplayerService_DeregisterServer_FullMethodName         = "/multiplayer.MultiplayerService/DeregisterServer"
+	MultiplayerService_AllocateServer_FullMethodName           = "/multiplayer.MultiplayerService/AllocateServer"
+	MultiplayerService_ReleaseServer_FullMethodName            = "/multiplayer.MultiplayerService/ReleaseServer"
+	MultiplayerService_PutRegion_FullMethodName                = "/multiplayer.MultiplayerService/PutRegion"
+	MultiplayerService_DeleteRegion_FullMethodName             = "/multiplayer.MultiplayerService/DeleteRegion"
+	MultiplayerService_ListRegions_FullMethodName              = "/multiplayer.MultiplayerService/ListRegions"
+	MultiplayerService_ReportLatencies_FullMethodName          = "/multiplayer.MultiplayerService/ReportLatencies"
+	MultiplayerService_RecommendArea_FullMethodName            = "/multiplayer.MultiplayerService/RecommendArea"
+	MultiplayerService_ReserveSeat_FullMethodName              = "/multiplayer.MultiplayerService/ReserveSeat"
+	MultiplayerService_ListOutboxConsumers_FullMethodName      = "/multiplayer.MultiplayerService/ListOutboxConsumers"
+	MultiplayerService_CreateWebhook_FullMethodName            = "/multiplayer.MultiplayerService/CreateWebhook"
+	MultiplayerService_DeleteWebhook_FullMethodName            = "/multiplayer.MultiplayerService/DeleteWebhook"
+	MultiplayerService_ListWebhooks_FullMethodName             = "/multiplayer.MultiplayerService/ListWebhooks"
+	MultiplayerService_ListWebhookDeliveries_FullMethodName    = "/multiplayer.MultiplayerService/ListWebhookDeliveries"
+	MultiplayerService_RetryWebhookDelivery_FullMethodName     = "/multiplayer.MultiplayerService/RetryWebhookDelivery"
 )
 
 // MultiplayerServiceClient is the client API for MultiplayerService service.
@@ -46,6 +87,64 @@ type MultiplayerServiceClient interface {
 	LeaveMode(ctx context.Context, in *LeaveModeRequest, opts ...grpc.CallOption) (*LeaveModeResponse, error)
 	GetPlayers(ctx context.Context, in *GetPlayersRequest, opts ...grpc.CallOption) (*GetPlayersResponse, error)
 	UpdateGameState(ctx context.Context, in *UpdateGameStateRequest, opts ...grpc.CallOption) (*UpdateGameStateResponse, error)
+	GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GameStateResponse, error)
+	// Game state timeline for support staff; restricted to admin identities
+	GetGameStateHistory(ctx context.Context, in *GameStateHistoryRequest, opts ...grpc.CallOption) (*GameStateHistoryResponse, error)
+	GetGameStateAt(ctx context.Context, in *GameStateAtRequest, opts ...grpc.CallOption) (*GameStateResponse, error)
+	// Historical active users
+	GetActiveUsersHistory(ctx context.Context, in *ActiveUsersHistoryRequest, opts ...grpc.CallOption) (*ActiveUsersHistoryResponse, error)
+	// Session analytics
+	GetSessionStats(ctx context.Context, in *SessionStatsRequest, opts ...grpc.CallOption) (*SessionStatsResponse, error)
+	// Daily and monthly unique players
+	GetUniquePlayers(ctx context.Context, in *UniquePlayersRequest, opts ...grpc.CallOption) (*UniquePlayersResponse, error)
+	// Leaderboards
+	SubmitScore(ctx context.Context, in *SubmitScoreRequest, opts ...grpc.CallOption) (*SubmitScoreResponse, error)
+	GetTopN(ctx context.Context, in *GetTopNRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+	GetPlayerRank(ctx context.Context, in *GetPlayerRankRequest, opts ...grpc.CallOption) (*GetPlayerRankResponse, error)
+	GetAroundPlayer(ctx context.Context, in *GetAroundPlayerRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+	// Competitive seasons; CreateSeason is restricted to admin identities
+	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error)
+	ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	RecordMatchOutcome(ctx context.Context, in *RecordMatchOutcomeRequest, opts ...grpc.CallOption) (*RecordMatchOutcomeResponse, error)
+	GetSeasonStandings(ctx context.Context, in *SeasonStandingsRequest, opts ...grpc.CallOption) (*SeasonStandingsResponse, error)
+	// Mode availability windows; restricted to admin identities
+	SetModeSchedule(ctx context.Context, in *SetModeScheduleRequest, opts ...grpc.CallOption) (*SetModeScheduleResponse, error)
+	// Maintenance drain; restricted to admin identities
+	DrainMode(ctx context.Context, in *DrainModeRequest, opts ...grpc.CallOption) (*DrainModeResponse, error)
+	ResumeMode(ctx context.Context, in *ResumeModeRequest, opts ...grpc.CallOption) (*ResumeModeResponse, error)
+	// Moderation; restricted to admin identities
+	KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*KickPlayerResponse, error)
+	BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*BanPlayerResponse, error)
+	ListSanctions(ctx context.Context, in *ListSanctionsRequest, opts ...grpc.CallOption) (*ListSanctionsResponse, error)
+	MutePlayer(ctx context.Context, in *MutePlayerRequest, opts ...grpc.CallOption) (*MutePlayerResponse, error)
+	// Spectators watch a mode without taking a player slot
+	JoinAsSpectator(ctx context.Context, in *SpectatorRequest, opts ...grpc.CallOption) (*SpectatorResponse, error)
+	LeaveSpectator(ctx context.Context, in *SpectatorRequest, opts ...grpc.CallOption) (*SpectatorResponse, error)
+	// In-mode chat for the mode's players; the first client message must be a join
+	Chat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatClientMessage, ChatServerMessage], error)
+	// Dedicated game server registry; servers stay allocatable while they keep sending heartbeats
+	RegisterServer(ctx context.Context, in *RegisterServerRequest, opts ...grpc.CallOption) (*RegisterServerResponse, error)
+	ServerHeartbeat(ctx context.Context, in *ServerHeartbeatRequest, opts ...grpc.CallOption) (*ServerHeartbeatResponse, error)
+	DeregisterServer(ctx context.Context, in *DeregisterServerRequest, opts ...grpc.CallOption) (*DeregisterServerResponse, error)
+	AllocateServer(ctx context.Context, in *AllocateServerRequest, opts ...grpc.CallOption) (*AllocateServerResponse, error)
+	ReleaseServer(ctx context.Context, in *ReleaseServerRequest, opts ...grpc.CallOption) (*ReleaseServerResponse, error)
+	// Region catalog; changes are restricted to admin identities
+	PutRegion(ctx context.Context, in *PutRegionRequest, opts ...grpc.CallOption) (*PutRegionResponse, error)
+	DeleteRegion(ctx context.Context, in *DeleteRegionRequest, opts ...grpc.CallOption) (*DeleteRegionResponse, error)
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error)
+	// Latency-based area recommendation
+	ReportLatencies(ctx context.Context, in *ReportLatenciesRequest, opts ...grpc.CallOption) (*ReportLatenciesResponse, error)
+	RecommendArea(ctx context.Context, in *RecommendAreaRequest, opts ...grpc.CallOption) (*RecommendAreaResponse, error)
+	// Holds a seat for a player until they join with the returned token
+	ReserveSeat(ctx context.Context, in *ReserveSeatRequest, opts ...grpc.CallOption) (*ReserveSeatResponse, error)
+	// Delivery progress of the change event outbox sinks; restricted to admin identities
+	ListOutboxConsumers(ctx context.Context, in *ListOutboxConsumersRequest, opts ...grpc.CallOption) (*ListOutboxConsumersResponse, error)
+	// Webhook subscriptions for mode events; restricted to admin identities
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*RetryWebhookDeliveryResponse, error)
 }
 
 type multiplayerServiceClient struct {
@@ -146,6 +245,419 @@ func (c *multiplayerServiceClient) UpdateGameState(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *multiplayerServiceClient) GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GameStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameStateResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetGameState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetGameStateHistory(ctx context.Context, in *GameStateHistoryRequest, opts ...grpc.CallOption) (*GameStateHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameStateHistoryResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetGameStateHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetGameStateAt(ctx context.Context, in *GameStateAtRequest, opts ...grpc.CallOption) (*GameStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameStateResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetGameStateAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetActiveUsersHistory(ctx context.Context, in *ActiveUsersHistoryRequest, opts ...grpc.CallOption) (*ActiveUsersHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActiveUsersHistoryResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetActiveUsersHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetSessionStats(ctx context.Context, in *SessionStatsRequest, opts ...grpc.CallOption) (*SessionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatsResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetSessionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetUniquePlayers(ctx context.Context, in *UniquePlayersRequest, opts ...grpc.CallOption) (*UniquePlayersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UniquePlayersResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetUniquePlayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) SubmitScore(ctx context.Context, in *SubmitScoreRequest, opts ...grpc.CallOption) (*SubmitScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitScoreResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_SubmitScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetTopN(ctx context.Context, in *GetTopNRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetTopN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetPlayerRank(ctx context.Context, in *GetPlayerRankRequest, opts ...grpc.CallOption) (*GetPlayerRankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayerRankResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetPlayerRank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetAroundPlayer(ctx context.Context, in *GetAroundPlayerRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetAroundPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSeasonResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_CreateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ListSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) RecordMatchOutcome(ctx context.Context, in *RecordMatchOutcomeRequest, opts ...grpc.CallOption) (*RecordMatchOutcomeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordMatchOutcomeResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_RecordMatchOutcome_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) GetSeasonStandings(ctx context.Context, in *SeasonStandingsRequest, opts ...grpc.CallOption) (*SeasonStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonStandingsResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_GetSeasonStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) SetModeSchedule(ctx context.Context, in *SetModeScheduleRequest, opts ...grpc.CallOption) (*SetModeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetModeScheduleResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_SetModeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) DrainMode(ctx context.Context, in *DrainModeRequest, opts ...grpc.CallOption) (*DrainModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrainModeResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_DrainMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ResumeMode(ctx context.Context, in *ResumeModeRequest, opts ...grpc.CallOption) (*ResumeModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeModeResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ResumeMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*KickPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickPlayerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_KickPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*BanPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanPlayerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_BanPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ListSanctions(ctx context.Context, in *ListSanctionsRequest, opts ...grpc.CallOption) (*ListSanctionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSanctionsResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ListSanctions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) MutePlayer(ctx context.Context, in *MutePlayerRequest, opts ...grpc.CallOption) (*MutePlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutePlayerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_MutePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) JoinAsSpectator(ctx context.Context, in *SpectatorRequest, opts ...grpc.CallOption) (*SpectatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpectatorResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_JoinAsSpectator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) LeaveSpectator(ctx context.Context, in *SpectatorRequest, opts ...grpc.CallOption) (*SpectatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpectatorResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_LeaveSpectator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) Chat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatClientMessage, ChatServerMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MultiplayerService_ServiceDesc.Streams[0], MultiplayerService_Chat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChatClientMessage, ChatServerMessage]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiplayerService_ChatClient = grpc.BidiStreamingClient[ChatClientMessage, ChatServerMessage]
+
+func (c *multiplayerServiceClient) RegisterServer(ctx context.Context, in *RegisterServerRequest, opts ...grpc.CallOption) (*RegisterServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterServerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_RegisterServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ServerHeartbeat(ctx context.Context, in *ServerHeartbeatRequest, opts ...grpc.CallOption) (*ServerHeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerHeartbeatResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ServerHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) DeregisterServer(ctx context.Context, in *DeregisterServerRequest, opts ...grpc.CallOption) (*DeregisterServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeregisterServerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_DeregisterServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) AllocateServer(ctx context.Context, in *AllocateServerRequest, opts ...grpc.CallOption) (*AllocateServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllocateServerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_AllocateServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ReleaseServer(ctx context.Context, in *ReleaseServerRequest, opts ...grpc.CallOption) (*ReleaseServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseServerResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ReleaseServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) PutRegion(ctx context.Context, in *PutRegionRequest, opts ...grpc.CallOption) (*PutRegionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutRegionResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_PutRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) DeleteRegion(ctx context.Context, in *DeleteRegionRequest, opts ...grpc.CallOption) (*DeleteRegionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRegionResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_DeleteRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionsResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ListRegions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ReportLatencies(ctx context.Context, in *ReportLatenciesRequest, opts ...grpc.CallOption) (*ReportLatenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportLatenciesResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ReportLatencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) RecommendArea(ctx context.Context, in *RecommendAreaRequest, opts ...grpc.CallOption) (*RecommendAreaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendAreaResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_RecommendArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ReserveSeat(ctx context.Context, in *ReserveSeatRequest, opts ...grpc.CallOption) (*ReserveSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSeatResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ReserveSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ListOutboxConsumers(ctx context.Context, in *ListOutboxConsumersRequest, opts ...grpc.CallOption) (*ListOutboxConsumersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutboxConsumersResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ListOutboxConsumers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiplayerServiceClient) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*RetryWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, MultiplayerService_RetryWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MultiplayerServiceServer is the server API for MultiplayerService service.
 // All implementations must embed UnimplementedMultiplayerServiceServer
 // for forward compatibility.
@@ -162,222 +674,1130 @@ type MultiplayerServiceServer interface {
 	LeaveMode(context.Context, *LeaveModeRequest) (*LeaveModeResponse, error)
 	GetPlayers(context.Context, *GetPlayersRequest) (*GetPlayersResponse, error)
 	UpdateGameState(context.Context, *UpdateGameStateRequest) (*UpdateGameStateResponse, error)
+	GetGameState(context.Context, *GetGameStateRequest) (*GameStateResponse, error)
+	// Game state timeline for support staff; restricted to admin identities
+	GetGameStateHistory(context.Context, *GameStateHistoryRequest) (*GameStateHistoryResponse, error)
+	GetGameStateAt(context.Context, *GameStateAtRequest) (*GameStateResponse, error)
+	// Historical active users
+	GetActiveUsersHistory(context.Context, *ActiveUsersHistoryRequest) (*ActiveUsersHistoryResponse, error)
+	// Session analytics
+	GetSessionStats(context.Context, *SessionStatsRequest) (*SessionStatsResponse, error)
+	// Daily and monthly unique players
+	GetUniquePlayers(context.Context, *UniquePlayersRequest) (*UniquePlayersResponse, error)
+	// Leaderboards
+	SubmitScore(context.Context, *SubmitScoreRequest) (*SubmitScoreResponse, error)
+	GetTopN(context.Context, *GetTopNRequest) (*LeaderboardResponse, error)
+	GetPlayerRank(context.Context, *GetPlayerRankRequest) (*GetPlayerRankResponse, error)
+	GetAroundPlayer(context.Context, *GetAroundPlayerRequest) (*LeaderboardResponse, error)
+	// Competitive seasons; CreateSeason is restricted to admin identities
+	CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error)
+	ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error)
+	RecordMatchOutcome(context.Context, *RecordMatchOutcomeRequest) (*RecordMatchOutcomeResponse, error)
+	GetSeasonStandings(context.Context, *SeasonStandingsRequest) (*SeasonStandingsResponse, error)
+	// Mode availability windows; restricted to admin identities
+	SetModeSchedule(context.Context, *SetModeScheduleRequest) (*SetModeScheduleResponse, error)
+	// Maintenance drain; restricted to admin identities
+	DrainMode(context.Context, *DrainModeRequest) (*DrainModeResponse, error)
+	ResumeMode(context.Context, *ResumeModeRequest) (*ResumeModeResponse, error)
+	// Moderation; restricted to admin identities
+	KickPlayer(context.Context, *KickPlayerRequest) (*KickPlayerResponse, error)
+	BanPlayer(context.Context, *BanPlayerRequest) (*BanPlayerResponse, error)
+	ListSanctions(context.Context, *ListSanctionsRequest) (*ListSanctionsResponse, error)
+	MutePlayer(context.Context, *MutePlayerRequest) (*MutePlayerResponse, error)
+	// Spectators watch a mode without taking a player slot
+	JoinAsSpectator(context.Context, *SpectatorRequest) (*SpectatorResponse, error)
+	LeaveSpectator(context.Context, *SpectatorRequest) (*SpectatorResponse, error)
+	// In-mode chat for the mode's players; the first client message must be a join
+	Chat(grpc.BidiStreamingServer[ChatClientMessage, ChatServerMessage]) error
+	// Dedicated game server registry; servers stay allocatable while they keep sending heartbeats
+	RegisterServer(context.Context, *RegisterServerRequest) (*RegisterServerResponse, error)
+	ServerHeartbeat(context.Context, *ServerHeartbeatRequest) (*ServerHeartbeatResponse, error)
+	DeregisterServer(context.Context, *DeregisterServerRequest) (*DeregisterServerResponse, error)
+	AllocateServer(context.Context, *AllocateServerRequest) (*AllocateServerResponse, error)
+	ReleaseServer(context.Context, *ReleaseServerRequest) (*ReleaseServerResponse, error)
+	// Region catalog; changes are restricted to admin identities
+	PutRegion(context.Context, *PutRegionRequest) (*PutRegionResponse, error)
+	DeleteRegion(context.Context, *DeleteRegionRequest) (*DeleteRegionResponse, error)
+	ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error)
+	// Latency-based area recommendation
+	ReportLatencies(context.Context, *ReportLatenciesRequest) (*ReportLatenciesResponse, error)
+	RecommendArea(context.Context, *RecommendAreaRequest) (*RecommendAreaResponse, error)
+	// Holds a seat for a player until they join with the returned token
+	ReserveSeat(context.Context, *ReserveSeatRequest) (*ReserveSeatResponse, error)
+	// Delivery progress of the change event outbox sinks; restricted to admin identities
+	ListOutboxConsumers(context.Context, *ListOutboxConsumersRequest) (*ListOutboxConsumersResponse, error)
+	// Webhook subscriptions for mode events; restricted to admin identities
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*RetryWebhookDeliveryResponse, error)
+	mustEmbedUnimplementedMultiplayerServiceServer()
+}
+
+// UnimplementedMultiplayerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMultiplayerServiceServer struct{}
+
+func (UnimplementedMultiplayerServiceServer) GetModeUsage(context.Context, *ModeUsageRequest) (*ModeUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModeUsage not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetTotalActiveUsers(context.Context, *TotalActiveUsersRequest) (*TotalActiveUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalActiveUsers not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetModeDetails(context.Context, *ModeDetailsRequest) (*ModeDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModeDetails not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetActiveUsersByAreaCode(context.Context, *ActiveUsersByAreaCodeRequest) (*ActiveUsersByAreaCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveUsersByAreaCode not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetGameModeStats(context.Context, *GameModeStatsRequest) (*GameModeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameModeStats not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) JoinMode(context.Context, *JoinModeRequest) (*JoinModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinMode not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) LeaveMode(context.Context, *LeaveModeRequest) (*LeaveModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveMode not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetPlayers(context.Context, *GetPlayersRequest) (*GetPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayers not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) UpdateGameState(context.Context, *UpdateGameStateRequest) (*UpdateGameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameState not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetGameState(context.Context, *GetGameStateRequest) (*GameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetGameStateHistory(context.Context, *GameStateHistoryRequest) (*GameStateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameStateHistory not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetGameStateAt(context.Context, *GameStateAtRequest) (*GameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameStateAt not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetActiveUsersHistory(context.Context, *ActiveUsersHistoryRequest) (*ActiveUsersHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveUsersHistory not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetSessionStats(context.Context, *SessionStatsRequest) (*SessionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionStats not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetUniquePlayers(context.Context, *UniquePlayersRequest) (*UniquePlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUniquePlayers not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) SubmitScore(context.Context, *SubmitScoreRequest) (*SubmitScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitScore not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetTopN(context.Context, *GetTopNRequest) (*LeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopN not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetPlayerRank(context.Context, *GetPlayerRankRequest) (*GetPlayerRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerRank not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetAroundPlayer(context.Context, *GetAroundPlayerRequest) (*LeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAroundPlayer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeason not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasons not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) RecordMatchOutcome(context.Context, *RecordMatchOutcomeRequest) (*RecordMatchOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMatchOutcome not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) GetSeasonStandings(context.Context, *SeasonStandingsRequest) (*SeasonStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonStandings not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) SetModeSchedule(context.Context, *SetModeScheduleRequest) (*SetModeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModeSchedule not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) DrainMode(context.Context, *DrainModeRequest) (*DrainModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainMode not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ResumeMode(context.Context, *ResumeModeRequest) (*ResumeModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMode not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) KickPlayer(context.Context, *KickPlayerRequest) (*KickPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) BanPlayer(context.Context, *BanPlayerRequest) (*BanPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPlayer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ListSanctions(context.Context, *ListSanctionsRequest) (*ListSanctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSanctions not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) MutePlayer(context.Context, *MutePlayerRequest) (*MutePlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutePlayer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) JoinAsSpectator(context.Context, *SpectatorRequest) (*SpectatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinAsSpectator not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) LeaveSpectator(context.Context, *SpectatorRequest) (*SpectatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveSpectator not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) Chat(grpc.BidiStreamingServer[ChatClientMessage, ChatServerMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) RegisterServer(context.Context, *RegisterServerRequest) (*RegisterServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterServer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ServerHeartbeat(context.Context, *ServerHeartbeatRequest) (*ServerHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerHeartbeat not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) DeregisterServer(context.Context, *DeregisterServerRequest) (*DeregisterServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterServer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) AllocateServer(context.Context, *AllocateServerRequest) (*AllocateServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateServer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ReleaseServer(context.Context, *ReleaseServerRequest) (*ReleaseServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseServer not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) PutRegion(context.Context, *PutRegionRequest) (*PutRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRegion not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) DeleteRegion(context.Context, *DeleteRegionRequest) (*DeleteRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRegion not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegions not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ReportLatencies(context.Context, *ReportLatenciesRequest) (*ReportLatenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportLatencies not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) RecommendArea(context.Context, *RecommendAreaRequest) (*RecommendAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendArea not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ReserveSeat(context.Context, *ReserveSeatRequest) (*ReserveSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveSeat not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ListOutboxConsumers(context.Context, *ListOutboxConsumersRequest) (*ListOutboxConsumersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxConsumers not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*RetryWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
+func (UnimplementedMultiplayerServiceServer) mustEmbedUnimplementedMultiplayerServiceServer() {}
+func (UnimplementedMultiplayerServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeMultiplayerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MultiplayerServiceServer will
+// result in compilation errors.
+type UnsafeMultiplayerServiceServer interface {
 	mustEmbedUnimplementedMultiplayerServiceServer()
 }
 
-// UnimplementedMultiplayerServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMultiplayerServiceServer struct{}
+func RegisterMultiplayerServiceServer(s grpc.ServiceRegistrar, srv MultiplayerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMultiplayerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MultiplayerService_ServiceDesc, srv)
+}
+
+func _MultiplayerService_GetModeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetModeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetModeUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetModeUsage(ctx, req.(*ModeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetTotalActiveUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalActiveUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetTotalActiveUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetTotalActiveUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetTotalActiveUsers(ctx, req.(*TotalActiveUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetModeDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetModeDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetModeDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetModeDetails(ctx, req.(*ModeDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetActiveUsersByAreaCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveUsersByAreaCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetActiveUsersByAreaCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetActiveUsersByAreaCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetActiveUsersByAreaCode(ctx, req.(*ActiveUsersByAreaCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetGameModeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameModeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetGameModeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetGameModeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetGameModeStats(ctx, req.(*GameModeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_JoinMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).JoinMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_JoinMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).JoinMode(ctx, req.(*JoinModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_LeaveMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).LeaveMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_LeaveMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).LeaveMode(ctx, req.(*LeaveModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetPlayers(ctx, req.(*GetPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_UpdateGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).UpdateGameState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_UpdateGameState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).UpdateGameState(ctx, req.(*UpdateGameStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetGameState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetGameState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetGameState(ctx, req.(*GetGameStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetGameStateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameStateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetGameStateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetGameStateHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetGameStateHistory(ctx, req.(*GameStateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetGameStateAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameStateAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetGameStateAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetGameStateAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetGameStateAt(ctx, req.(*GameStateAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetActiveUsersHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveUsersHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetActiveUsersHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetActiveUsersHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetActiveUsersHistory(ctx, req.(*ActiveUsersHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetSessionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetSessionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetSessionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetSessionStats(ctx, req.(*SessionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedMultiplayerServiceServer) GetModeUsage(context.Context, *ModeUsageRequest) (*ModeUsageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetModeUsage not implemented")
+func _MultiplayerService_GetUniquePlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniquePlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetUniquePlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetUniquePlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetUniquePlayers(ctx, req.(*UniquePlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) GetTotalActiveUsers(context.Context, *TotalActiveUsersRequest) (*TotalActiveUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalActiveUsers not implemented")
+
+func _MultiplayerService_SubmitScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).SubmitScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_SubmitScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).SubmitScore(ctx, req.(*SubmitScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetTopN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetTopN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetTopN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetTopN(ctx, req.(*GetTopNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetPlayerRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetPlayerRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetPlayerRank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetPlayerRank(ctx, req.(*GetPlayerRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetAroundPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAroundPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetAroundPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetAroundPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetAroundPlayer(ctx, req.(*GetAroundPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_CreateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).CreateSeason(ctx, req.(*CreateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).ListSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_ListSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).ListSeasons(ctx, req.(*ListSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_RecordMatchOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMatchOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).RecordMatchOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_RecordMatchOutcome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).RecordMatchOutcome(ctx, req.(*RecordMatchOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_GetSeasonStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).GetSeasonStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_GetSeasonStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).GetSeasonStandings(ctx, req.(*SeasonStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_SetModeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetModeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).SetModeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_SetModeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).SetModeSchedule(ctx, req.(*SetModeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_DrainMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).DrainMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_DrainMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).DrainMode(ctx, req.(*DrainModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_ResumeMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).ResumeMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_ResumeMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).ResumeMode(ctx, req.(*ResumeModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_KickPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).KickPlayer(ctx, req.(*KickPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_BanPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).BanPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_BanPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).BanPlayer(ctx, req.(*BanPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_ListSanctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSanctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).ListSanctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_ListSanctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).ListSanctions(ctx, req.(*ListSanctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_MutePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).MutePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_MutePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).MutePlayer(ctx, req.(*MutePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_JoinAsSpectator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).JoinAsSpectator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_JoinAsSpectator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).JoinAsSpectator(ctx, req.(*SpectatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiplayerService_LeaveSpectator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).LeaveSpectator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_LeaveSpectator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).LeaveSpectator(ctx, req.(*SpectatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) GetModeDetails(context.Context, *ModeDetailsRequest) (*ModeDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetModeDetails not implemented")
+
+func _MultiplayerService_Chat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MultiplayerServiceServer).Chat(&grpc.GenericServerStream[ChatClientMessage, ChatServerMessage]{ServerStream: stream})
 }
-func (UnimplementedMultiplayerServiceServer) GetActiveUsersByAreaCode(context.Context, *ActiveUsersByAreaCodeRequest) (*ActiveUsersByAreaCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveUsersByAreaCode not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MultiplayerService_ChatServer = grpc.BidiStreamingServer[ChatClientMessage, ChatServerMessage]
+
+func _MultiplayerService_RegisterServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).RegisterServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_RegisterServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).RegisterServer(ctx, req.(*RegisterServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) GetGameModeStats(context.Context, *GameModeStatsRequest) (*GameModeStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGameModeStats not implemented")
+
+func _MultiplayerService_ServerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).ServerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_ServerHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).ServerHeartbeat(ctx, req.(*ServerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) JoinMode(context.Context, *JoinModeRequest) (*JoinModeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinMode not implemented")
+
+func _MultiplayerService_DeregisterServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).DeregisterServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_DeregisterServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).DeregisterServer(ctx, req.(*DeregisterServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) LeaveMode(context.Context, *LeaveModeRequest) (*LeaveModeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveMode not implemented")
+
+func _MultiplayerService_AllocateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).AllocateServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_AllocateServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).AllocateServer(ctx, req.(*AllocateServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) GetPlayers(context.Context, *GetPlayersRequest) (*GetPlayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPlayers not implemented")
+
+func _MultiplayerService_ReleaseServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).ReleaseServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_ReleaseServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).ReleaseServer(ctx, req.(*ReleaseServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) UpdateGameState(context.Context, *UpdateGameStateRequest) (*UpdateGameStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameState not implemented")
+
+func _MultiplayerService_PutRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).PutRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_PutRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).PutRegion(ctx, req.(*PutRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMultiplayerServiceServer) mustEmbedUnimplementedMultiplayerServiceServer() {}
-func (UnimplementedMultiplayerServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafeMultiplayerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MultiplayerServiceServer will
-// result in compilation errors.
-type UnsafeMultiplayerServiceServer interface {
-	mustEmbedUnimplementedMultiplayerServiceServer()
+func _MultiplayerService_DeleteRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).DeleteRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_DeleteRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).DeleteRegion(ctx, req.(*DeleteRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMultiplayerServiceServer(s grpc.ServiceRegistrar, srv MultiplayerServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMultiplayerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MultiplayerService_ListRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MultiplayerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MultiplayerServiceServer).ListRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiplayerService_ListRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiplayerServiceServer).ListRegions(ctx, req.(*ListRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_GetModeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModeUsageRequest)
+func _MultiplayerService_ReportLatencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLatenciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).GetModeUsage(ctx, in)
+		return srv.(MultiplayerServiceServer).ReportLatencies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_GetModeUsage_FullMethodName,
+		FullMethod: MultiplayerService_ReportLatencies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).GetModeUsage(ctx, req.(*ModeUsageRequest))
+		return srv.(MultiplayerServiceServer).ReportLatencies(ctx, req.(*ReportLatenciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_GetTotalActiveUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalActiveUsersRequest)
+func _MultiplayerService_RecommendArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendAreaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).GetTotalActiveUsers(ctx, in)
+		return srv.(MultiplayerServiceServer).RecommendArea(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_GetTotalActiveUsers_FullMethodName,
+		FullMethod: MultiplayerService_RecommendArea_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).GetTotalActiveUsers(ctx, req.(*TotalActiveUsersRequest))
+		return srv.(MultiplayerServiceServer).RecommendArea(ctx, req.(*RecommendAreaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_GetModeDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModeDetailsRequest)
+func _MultiplayerService_ReserveSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).GetModeDetails(ctx, in)
+		return srv.(MultiplayerServiceServer).ReserveSeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_GetModeDetails_FullMethodName,
+		FullMethod: MultiplayerService_ReserveSeat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).GetModeDetails(ctx, req.(*ModeDetailsRequest))
+		return srv.(MultiplayerServiceServer).ReserveSeat(ctx, req.(*ReserveSeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_GetActiveUsersByAreaCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActiveUsersByAreaCodeRequest)
+func _MultiplayerService_ListOutboxConsumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxConsumersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).GetActiveUsersByAreaCode(ctx, in)
+		return srv.(MultiplayerServiceServer).ListOutboxConsumers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_GetActiveUsersByAreaCode_FullMethodName,
+		FullMethod: MultiplayerService_ListOutboxConsumers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).GetActiveUsersByAreaCode(ctx, req.(*ActiveUsersByAreaCodeRequest))
+		return srv.(MultiplayerServiceServer).ListOutboxConsumers(ctx, req.(*ListOutboxConsumersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_GetGameModeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GameModeStatsRequest)
+func _MultiplayerService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).GetGameModeStats(ctx, in)
+		return srv.(MultiplayerServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_GetGameModeStats_FullMethodName,
+		FullMethod: MultiplayerService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).GetGameModeStats(ctx, req.(*GameModeStatsRequest))
+		return srv.(MultiplayerServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_JoinMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinModeRequest)
+func _MultiplayerService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).JoinMode(ctx, in)
+		return srv.(MultiplayerServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_JoinMode_FullMethodName,
+		FullMethod: MultiplayerService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).JoinMode(ctx, req.(*JoinModeRequest))
+		return srv.(MultiplayerServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_LeaveMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveModeRequest)
+func _MultiplayerService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).LeaveMode(ctx, in)
+		return srv.(MultiplayerServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_LeaveMode_FullMethodName,
+		FullMethod: MultiplayerService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).LeaveMode(ctx, req.(*LeaveModeRequest))
+		return srv.(MultiplayerServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_GetPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPlayersRequest)
+func _MultiplayerService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).GetPlayers(ctx, in)
+		return srv.(MultiplayerServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_GetPlayers_FullMethodName,
+		FullMethod: MultiplayerService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).GetPlayers(ctx, req.(*GetPlayersRequest))
+		return srv.(MultiplayerServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MultiplayerService_UpdateGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGameStateRequest)
+func _MultiplayerService_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MultiplayerServiceServer).UpdateGameState(ctx, in)
+		return srv.(MultiplayerServiceServer).RetryWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MultiplayerService_UpdateGameState_FullMethodName,
+		FullMethod: MultiplayerService_RetryWebhookDelivery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MultiplayerServiceServer).UpdateGameState(ctx, req.(*UpdateGameStateRequest))
+		return srv.(MultiplayerServiceServer).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -425,7 +1845,174 @@ var MultiplayerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGameState",
 			Handler:    _MultiplayerService_UpdateGameState_Handler,
 		},
+		{
+			MethodName: "GetGameState",
+			Handler:    _MultiplayerService_GetGameState_Handler,
+		},
+		{
+			MethodName: "GetGameStateHistory",
+			Handler:    _MultiplayerService_GetGameStateHistory_Handler,
+		},
+		{
+			MethodName: "GetGameStateAt",
+			Handler:    _MultiplayerService_GetGameStateAt_Handler,
+		},
+		{
+			MethodName: "GetActiveUsersHistory",
+			Handler:    _MultiplayerService_GetActiveUsersHistory_Handler,
+		},
+		{
+			MethodName: "GetSessionStats",
+			Handler:    _MultiplayerService_GetSessionStats_Handler,
+		},
+		{
+			MethodName: "GetUniquePlayers",
+			Handler:    _MultiplayerService_GetUniquePlayers_Handler,
+		},
+		{
+			MethodName: "SubmitScore",
+			Handler:    _MultiplayerService_SubmitScore_Handler,
+		},
+		{
+			MethodName: "GetTopN",
+			Handler:    _MultiplayerService_GetTopN_Handler,
+		},
+		{
+			MethodName: "GetPlayerRank",
+			Handler:    _MultiplayerService_GetPlayerRank_Handler,
+		},
+		{
+			MethodName: "GetAroundPlayer",
+			Handler:    _MultiplayerService_GetAroundPlayer_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _MultiplayerService_CreateSeason_Handler,
+		},
+		{
+			MethodName: "ListSeasons",
+			Handler:    _MultiplayerService_ListSeasons_Handler,
+		},
+		{
+			MethodName: "RecordMatchOutcome",
+			Handler:    _MultiplayerService_RecordMatchOutcome_Handler,
+		},
+		{
+			MethodName: "GetSeasonStandings",
+			Handler:    _MultiplayerService_GetSeasonStandings_Handler,
+		},
+		{
+			MethodName: "SetModeSchedule",
+			Handler:    _MultiplayerService_SetModeSchedule_Handler,
+		},
+		{
+			MethodName: "DrainMode",
+			Handler:    _MultiplayerService_DrainMode_Handler,
+		},
+		{
+			MethodName: "ResumeMode",
+			Handler:    _MultiplayerService_ResumeMode_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _MultiplayerService_KickPlayer_Handler,
+		},
+		{
+			MethodName: "BanPlayer",
+			Handler:    _MultiplayerService_BanPlayer_Handler,
+		},
+		{
+			MethodName: "ListSanctions",
+			Handler:    _MultiplayerService_ListSanctions_Handler,
+		},
+		{
+			MethodName: "MutePlayer",
+			Handler:    _MultiplayerService_MutePlayer_Handler,
+		},
+		{
+			MethodName: "JoinAsSpectator",
+			Handler:    _MultiplayerService_JoinAsSpectator_Handler,
+		},
+		{
+			MethodName: "LeaveSpectator",
+			Handler:    _MultiplayerService_LeaveSpectator_Handler,
+		},
+		{
+			MethodName: "RegisterServer",
+			Handler:    _MultiplayerService_RegisterServer_Handler,
+		},
+		{
+			MethodName: "ServerHeartbeat",
+			Handler:    _MultiplayerService_ServerHeartbeat_Handler,
+		},
+		{
+			MethodName: "DeregisterServer",
+			Handler:    _MultiplayerService_DeregisterServer_Handler,
+		},
+		{
+			MethodName: "AllocateServer",
+			Handler:    _MultiplayerService_AllocateServer_Handler,
+		},
+		{
+			MethodName: "ReleaseServer",
+			Handler:    _MultiplayerService_ReleaseServer_Handler,
+		},
+		{
+			MethodName: "PutRegion",
+			Handler:    _MultiplayerService_PutRegion_Handler,
+		},
+		{
+			MethodName: "DeleteRegion",
+			Handler:    _MultiplayerService_DeleteRegion_Handler,
+		},
+		{
+			MethodName: "ListRegions",
+			Handler:    _MultiplayerService_ListRegions_Handler,
+		},
+		{
+			MethodName: "ReportLatencies",
+			Handler:    _MultiplayerService_ReportLatencies_Handler,
+		},
+		{
+			MethodName: "RecommendArea",
+			Handler:    _MultiplayerService_RecommendArea_Handler,
+		},
+		{
+			MethodName: "ReserveSeat",
+			Handler:    _MultiplayerService_ReserveSeat_Handler,
+		},
+		{
+			MethodName: "ListOutboxConsumers",
+			Handler:    _MultiplayerService_ListOutboxConsumers_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _MultiplayerService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _MultiplayerService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _MultiplayerService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _MultiplayerService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _MultiplayerService_RetryWebhookDelivery_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Chat",
+			Handler:       _MultiplayerService_Chat_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "multiplayer.proto",
 }
@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limiter is a token bucket whose rate and burst can be changed at runtime.
+// A rate of 0 disables limiting.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter initializes a new Limiter allowing rate requests per second with the given burst.
+func NewLimiter(rate float64, burst int) *Limiter {
+	l := &Limiter{last: time.Now()}
+	l.SetLimit(rate, burst)
+	return l
+}
+
+// SetLimit changes the rate and burst. The bucket starts full after every change.
+func (l *Limiter) SetLimit(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = rate
+	l.burst = float64(burst)
+	l.tokens = l.burst
+	l.last = time.Now()
+}
+
+// Allow reports whether one more request may proceed now and consumes a token if so.
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return true
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// UnaryServerInterceptor rejects calls with codes.ResourceExhausted once the limit is exceeded.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !l.Allow() {
+			return nil, status.Errorf(codes.ResourceExhausted, "Rate limit exceeded, retry later")
+		}
+		return handler(ctx, req)
+	}
+}
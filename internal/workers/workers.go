@@ -0,0 +1,24 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunPeriodic calls fn every interval until ctx is cancelled. Errors are logged and the
+// worker keeps running; fn receives ctx so in-progress work is abandoned on shutdown.
+func RunPeriodic(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil && ctx.Err() == nil {
+				slog.Error("Background worker failed", "worker", name, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestWordFilterMasksWholeWordsOnly(t *testing.T) {
+	filter := logic.NewWordFilter([]string{"darn"})
+	msg := &logic.ChatMessage{Text: "Darn it, darned darn!"}
+	if err := filter.FilterMessage(context.Background(), msg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if msg.Text != "**** it, darned ****!" {
+		t.Fatalf("unexpected filtered text %q", msg.Text)
+	}
+}
+
+func TestChatIsLimitedToPlayersAndFansOut(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := collection.Database().Collection("sanctions").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop sanctions: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	redisCache.Delete(ctx, "chat_history:Mode1")
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", ActiveUsers: 2, Players: []string{"player1", "player2"}})
+	hooks := logic.ChatHooks{Mutes: logic.NewSanctionMuteChecker(collection)}
+
+	if _, err := logic.SubscribeChatLogic(ctx, collection, redisCache, "Mode1", "outsider", 10); !errors.Is(err, logic.ErrPlayerNotInMode) {
+		t.Fatalf("expected ErrPlayerNotInMode, got %v", err)
+	}
+	if _, err := logic.SendChatMessageLogic(ctx, collection, redisCache, hooks, "Mode1", "player1", "first", 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	sub, err := logic.SubscribeChatLogic(ctx, collection, redisCache, "Mode1", "player2", 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer sub.Close()
+	if len(sub.History) != 1 || sub.History[0].Text != "first" {
+		t.Fatalf("expected the first message in the history, got %+v", sub.History)
+	}
+
+	if _, err := logic.SendChatMessageLogic(ctx, collection, redisCache, hooks, "Mode1", "player1", "second", 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	select {
+	case msg := <-sub.Messages():
+		if msg.Text != "second" || msg.PlayerID != "player1" {
+			t.Fatalf("unexpected message %+v", msg)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected the message to be delivered to the subscriber")
+	}
+
+	if _, err := logic.MutePlayerLogic(ctx, collection, "player1", "Mode1", time.Minute, "spamming", "mod"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := logic.SendChatMessageLogic(ctx, collection, redisCache, hooks, "Mode1", "player1", "third", 10); !errors.Is(err, logic.ErrPlayerMuted) {
+		t.Fatalf("expected ErrPlayerMuted, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/handlers"
+	"multiplayer-webservice/internal/logic"
+	"multiplayer-webservice/internal/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrainModeRejectsJoinsAndAnnouncesEmptyMode(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "123", ActiveUsers: 1, Players: []string{"player1"}})
+
+	events := redisCache.Client.Subscribe(ctx, logic.ModeEventsChannel)
+	defer events.Close()
+	if _, err := events.Receive(ctx); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+
+	service := handlers.NewMultiplayerService(collection, redisCache)
+	if _, err := logic.DrainModeLogic(ctx, collection, redisCache, "Mode1", time.Minute, "patching"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	_, err = service.JoinMode(ctx, &proto.JoinModeRequest{ModeName: "Mode1", PlayerId: "player2"})
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	if len(st.Details()) != 1 || st.Details()[0].(*errdetails.RetryInfo).GetRetryDelay().AsDuration() != time.Minute {
+		t.Fatalf("expected a one minute retry hint, got %v", st.Details())
+	}
+
+	if err := logic.LeaveModeLogic(ctx, collection, redisCache, "Mode1", "player1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	msg, err := events.ReceiveMessage(ctx)
+	if err != nil {
+		t.Fatalf("expected a mode event, got %v", err)
+	}
+	var event logic.ModeEvent
+	if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil || event.Type != logic.ModeEventDrained || event.ModeName != "Mode1" {
+		t.Fatalf("expected a mode_drained event for Mode1, got %s", msg.Payload)
+	}
+
+	if err := logic.ResumeModeLogic(ctx, collection, "Mode1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player2"); err != nil {
+		t.Fatalf("expected join after resume to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestAllocateServerPicksTheLeastLoadedHealthyServer(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("game_servers").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop game servers: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1"})
+
+	now := time.Now()
+	ttl := 30 * time.Second
+	servers := []logic.GameServer{
+		{ServerID: "big", Address: "10.0.0.1:7777", AreaCode: "eu", Capacity: 4},
+		{ServerID: "small", Address: "10.0.0.2:7777", AreaCode: "eu", Capacity: 1},
+		{ServerID: "other-mode", Address: "10.0.0.3:7777", AreaCode: "eu", Capacity: 10, Modes: []string{"Mode2"}},
+	}
+	for _, server := range servers {
+		if err := logic.RegisterServerLogic(ctx, collection, server, now); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	// A server that stopped sending heartbeats is never picked
+	if err := logic.RegisterServerLogic(ctx, collection, logic.GameServer{ServerID: "stale", Address: "10.0.0.4:7777", AreaCode: "eu", Capacity: 10}, now.Add(-time.Minute)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Loads go big 0/4 -> small 0/1 -> big 1/4 ... until only big has room left
+	var picked []string
+	var first *logic.ServerAllocation
+	for i := 0; i < 5; i++ {
+		allocation, err := logic.AllocateServerLogic(ctx, collection, "Mode1", "eu", ttl, now)
+		if err != nil {
+			t.Fatalf("allocation %d: expected no error, got %v", i, err)
+		}
+		if first == nil {
+			first = allocation
+		}
+		picked = append(picked, allocation.ServerID)
+	}
+	want := []string{"big", "small", "big", "big", "big"}
+	for i := range want {
+		if picked[i] != want[i] {
+			t.Fatalf("expected allocations %v, got %v", want, picked)
+		}
+	}
+	if _, err := logic.AllocateServerLogic(ctx, collection, "Mode1", "eu", ttl, now); !errors.Is(err, logic.ErrNoServerAvailable) {
+		t.Fatalf("expected ErrNoServerAvailable, got %v", err)
+	}
+
+	if err := logic.ReleaseServerLogic(ctx, collection, first.ServerID, first.AllocationID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.ReleaseServerLogic(ctx, collection, first.ServerID, first.AllocationID); !errors.Is(err, logic.ErrAllocationNotFound) {
+		t.Fatalf("expected ErrAllocationNotFound, got %v", err)
+	}
+	if allocation, err := logic.AllocateServerLogic(ctx, collection, "Mode1", "", ttl, now); err != nil || allocation.ServerID != "big" {
+		t.Fatalf("expected the released slot to be allocated again, got %+v (%v)", allocation, err)
+	}
+
+	evicted, err := logic.EvictStaleServersLogic(ctx, collection, ttl, now)
+	if err != nil || evicted != 1 {
+		t.Fatalf("expected the stale server to be evicted, got %d (%v)", evicted, err)
+	}
+	if err := logic.ServerHeartbeatLogic(ctx, collection, "stale", now); !errors.Is(err, logic.ErrServerNotFound) {
+		t.Fatalf("expected ErrServerNotFound, got %v", err)
+	}
+}
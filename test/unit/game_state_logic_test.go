@@ -0,0 +1,133 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/logic"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestGameStatePatchesMergeAndDetectConflicts(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", GameState: "active"})
+
+	state, err := logic.PatchGameStateLogic(ctx, collection, redisCache, "Mode1", logic.GameStatePatch{
+		Data: map[string]interface{}{"map": "dust", "round": 1.0, "scores": map[string]interface{}{"red": 0.0, "blue": 0.0}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if state.Version != 1 || state.Status != "active" {
+		t.Fatalf("expected version 1 with status unchanged, got %d %q", state.Version, state.Status)
+	}
+
+	// Nested objects are merged and null deletes a key
+	version := state.Version
+	state, err = logic.PatchGameStateLogic(ctx, collection, redisCache, "Mode1", logic.GameStatePatch{
+		Data:            map[string]interface{}{"map": nil, "scores": map[string]interface{}{"red": 3.0}},
+		ExpectedVersion: &version,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	scores := state.Data["scores"].(map[string]interface{})
+	if _, ok := state.Data["map"]; ok || scores["red"] != 3.0 || scores["blue"] != 0.0 || state.Data["round"] != 1.0 {
+		t.Fatalf("unexpected merged state %v", state.Data)
+	}
+
+	// A writer still holding version 1 must not overwrite the update above
+	_, err = logic.PatchGameStateLogic(ctx, collection, redisCache, "Mode1", logic.GameStatePatch{
+		Data:            map[string]interface{}{"round": 5.0},
+		ExpectedVersion: &version,
+	})
+	if !errors.Is(err, logic.ErrGameStateConflict) {
+		t.Fatalf("expected ErrGameStateConflict, got %v", err)
+	}
+
+	state, err = logic.PatchGameStateLogic(ctx, collection, redisCache, "Mode1", logic.GameStatePatch{
+		Status:  "ended",
+		Data:    map[string]interface{}{"winner": "red"},
+		Replace: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if state.Version != 3 || state.Status != "ended" || len(state.Data) != 1 {
+		t.Fatalf("expected the replaced state at version 3, got %d %q %v", state.Version, state.Status, state.Data)
+	}
+
+	if _, err := logic.GetGameStateLogic(ctx, collection, "Missing"); !errors.Is(err, logic.ErrModeNotFound) {
+		t.Fatalf("expected ErrModeNotFound, got %v", err)
+	}
+}
+
+func TestGameStateHistoryReplaysTheTimeline(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("game_state_history").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop game state history: %v", err)
+	}
+	if err := logic.EnsureIndexes(ctx, collection); err != nil {
+		t.Fatalf("Failed to create indexes: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", GameState: "active"})
+
+	before := time.Now()
+	for round := 1; round <= 3; round++ {
+		_, err := logic.PatchGameStateLogic(ctx, collection, redisCache, "Mode1", logic.GameStatePatch{
+			Data:      map[string]interface{}{"round": float64(round)},
+			UpdatedBy: "server-1",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	history, err := logic.GetGameStateHistoryLogic(ctx, collection, "Mode1", time.Time{}, time.Time{}, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(history) != 3 || history[0].Version != 1 || history[2].Data["round"] != 3.0 || history[1].UpdatedBy != "server-1" {
+		t.Fatalf("unexpected history %+v", history)
+	}
+
+	at, err := logic.GetGameStateAtLogic(ctx, collection, "Mode1", history[1].UpdatedAt.Add(5*time.Millisecond))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if at.Version != 2 || at.Data["round"] != 2.0 {
+		t.Fatalf("expected version 2 with round 2, got %d %v", at.Version, at.Data)
+	}
+	if _, err := logic.GetGameStateAtLogic(ctx, collection, "Mode1", before.Add(-time.Minute)); !errors.Is(err, logic.ErrGameStateNotRecorded) {
+		t.Fatalf("expected ErrGameStateNotRecorded, got %v", err)
+	}
+
+	// A recreated mode starts again at version 1 without colliding with the old entries
+	collection.DeleteOne(ctx, bson.M{"mode_name": "Mode1"})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", GameState: "active"})
+	if _, err := logic.PatchGameStateLogic(ctx, collection, redisCache, "Mode1", logic.GameStatePatch{Data: map[string]interface{}{"round": 1.0}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	history, err = logic.GetGameStateHistoryLogic(ctx, collection, "Mode1", time.Time{}, time.Time{}, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(history) != 4 || history[3].Version != 1 {
+		t.Fatalf("expected the recreated mode's update at the end of the history, got %+v", history)
+	}
+}
@@ -0,0 +1,48 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestActiveUsersHistorySampleAndDownsample(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("active_users_history").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop history collection: %v", err)
+	}
+
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "123", ActiveUsers: 4})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode2", AreaCode: "123", ActiveUsers: 6})
+
+	start := time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC)
+	if err := logic.SampleActiveUsersLogic(ctx, collection, start); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode3", AreaCode: "123", ActiveUsers: 10})
+	if err := logic.SampleActiveUsersLogic(ctx, collection, start.Add(30*time.Minute)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Roll both raw samples up into a single hourly sample
+	if err := logic.DownsampleActiveUsersLogic(ctx, collection, start.Add(48*time.Hour), 24*time.Hour, 90*24*time.Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	history, err := logic.GetActiveUsersHistoryLogic(ctx, collection, logic.HistoryScopeArea, "123", start, start.Add(time.Hour), time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(history.Points) != 1 {
+		t.Fatalf("expected 1 point, got %d", len(history.Points))
+	}
+	if history.Points[0].Average != 15 {
+		t.Fatalf("expected average 15, got %v", history.Points[0].Average)
+	}
+	if history.Peak != 20 || !history.PeakTime.Equal(start.Add(30*time.Minute)) {
+		t.Fatalf("expected peak 20 at %v, got %d at %v", start.Add(30*time.Minute), history.Peak, history.PeakTime)
+	}
+}
@@ -0,0 +1,102 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/idempotency"
+	pb "multiplayer-webservice/internal/proto"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestIdempotencyKeyReplaysTheOriginalResponse(t *testing.T) {
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	interceptor := idempotency.NewStore(redisCache, time.Minute).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/multiplayer.MultiplayerService/JoinMode"}
+	key := fmt.Sprintf("join-%d", time.Now().UnixNano())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotency.MetadataKey, key))
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, status.Error(codes.Unavailable, "try again")
+		}
+		return &pb.JoinModeResponse{Message: fmt.Sprintf("joined %d", calls)}, nil
+	}
+	req := &pb.JoinModeRequest{ModeName: "Mode1", PlayerId: "player1"}
+
+	// A failed call releases the key so the retry runs again
+	if _, err := interceptor(ctx, req, info, handler); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	resp, err := interceptor(ctx, req, info, handler)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	replayed, err := interceptor(ctx, req, info, handler)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the handler to run twice, ran %d times", calls)
+	}
+	if got := replayed.(*pb.JoinModeResponse).Message; got != resp.(*pb.JoinModeResponse).Message {
+		t.Fatalf("expected the stored response %q, got %q", resp.(*pb.JoinModeResponse).Message, got)
+	}
+
+	other := &pb.JoinModeRequest{ModeName: "Mode2", PlayerId: "player1"}
+	if _, err := interceptor(ctx, other, info, handler); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a reused key, got %v", err)
+	}
+
+	// Without a key every call runs
+	if _, err := interceptor(context.Background(), req, info, handler); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected the handler to run without a key, ran %d times", calls)
+	}
+}
+
+func TestIdempotencyRunsWithoutRedis(t *testing.T) {
+	// Nothing listens on port 1, so every Redis command fails fast
+	redisCache := &cache.RedisCache{Client: redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})}
+	defer redisCache.Client.Close()
+	interceptor := idempotency.NewStore(redisCache, time.Minute).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/multiplayer.MultiplayerService/LeaveMode"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotency.MetadataKey, "leave-1"))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.LeaveModeResponse{Message: "left"}, nil
+	}
+	resp, err := interceptor(ctx, &pb.LeaveModeRequest{ModeName: "Mode1", PlayerId: "player1"}, info, handler)
+	if err != nil {
+		t.Fatalf("expected the call to run without the store, got %v", err)
+	}
+	if resp.(*pb.LeaveModeResponse).Message != "left" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+
+	if _, err := interceptor(ctx, &pb.LeaveModeRequest{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	}); err == nil {
+		t.Fatalf("expected the handler error to be returned")
+	}
+}
@@ -0,0 +1,64 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestLeaderboardSurvivesRedisFlush(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("leaderboards").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop leaderboards collection: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	board := logic.Leaderboard{ModeName: "Mode1", Season: "s1"}
+	redisCache.Client.Del(ctx, "leaderboard:Mode1::s1")
+
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "123"})
+	for player, score := range map[string]float64{"alice": 30, "bob": 50, "carol": 10} {
+		if _, err := logic.SubmitScoreLogic(ctx, collection, redisCache, board, player, score); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	// A lower score does not replace the best one
+	entry, err := logic.SubmitScoreLogic(ctx, collection, redisCache, board, "bob", 20)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry.Score != 50 || entry.Rank != 1 {
+		t.Fatalf("expected bob to keep 50 at rank 1, got %v at rank %d", entry.Score, entry.Rank)
+	}
+
+	if err := logic.PersistLeaderboardsLogic(ctx, collection, redisCache); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	redisCache.Client.Del(ctx, "leaderboard:Mode1::s1")
+
+	top, err := logic.GetTopNLogic(ctx, collection, redisCache, board, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(top) != 2 || top[0].PlayerID != "bob" || top[1].PlayerID != "alice" {
+		t.Fatalf("expected bob and alice restored from MongoDB, got %+v", top)
+	}
+
+	around, err := logic.GetAroundPlayerLogic(ctx, collection, redisCache, board, "carol", 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(around) != 2 || around[0].Rank != 2 || around[1].PlayerID != "carol" {
+		t.Fatalf("expected alice and carol around carol, got %+v", around)
+	}
+
+	if _, err := logic.GetPlayerRankLogic(ctx, collection, redisCache, board, "dave"); err != logic.ErrPlayerNotRanked {
+		t.Fatalf("expected ErrPlayerNotRanked, got %v", err)
+	}
+}
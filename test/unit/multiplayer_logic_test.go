@@ -164,6 +164,28 @@ func TestLeaveModeLogic(t *testing.T) {
     }
 }
 
+func TestLeaveModeLogicIgnoresUnknownModes(t *testing.T) {
+    collection := setupTestDB(t)
+    ctx := context.Background()
+
+    redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+    if err != nil {
+        t.Fatalf("Failed to initialize Redis cache: %v", err)
+    }
+
+    if err := logic.LeaveModeLogic(ctx, collection, redisCache, "NoSuchMode", "player1"); err != nil {
+        t.Fatalf("expected no error, got %v", err)
+    }
+    events, err := collection.Database().Collection("outbox").CountDocuments(ctx, bson.M{"mode_name": "NoSuchMode"})
+    if err != nil || events != 0 {
+        t.Fatalf("expected no outbox event for an unknown mode, got %d, %v", events, err)
+    }
+    sessions, err := collection.Database().Collection("sessions").CountDocuments(ctx, bson.M{"mode_name": "NoSuchMode"})
+    if err != nil || sessions != 0 {
+        t.Fatalf("expected no session for an unknown mode, got %d, %v", sessions, err)
+    }
+}
+
 func TestGetModeDetailsLogic(t *testing.T) {
 	collection := setupTestDB(t)
 	ctx := context.Background()
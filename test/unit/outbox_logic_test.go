@@ -0,0 +1,129 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+)
+
+// recordingSink keeps every batch it is sent and fails the first failures calls.
+type recordingSink struct {
+	failures int
+	events   []logic.OutboxEvent
+}
+
+func (s *recordingSink) Name() string {
+	return "test-sink"
+}
+
+func (s *recordingSink) Publish(ctx context.Context, events []logic.OutboxEvent) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink unavailable")
+	}
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func TestOutboxRelaysModeChangesAtLeastOnce(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	for _, name := range []string{"outbox", "outbox_offsets", "outbox_sequence"} {
+		if err := collection.Database().Collection(name).Drop(ctx); err != nil {
+			t.Fatalf("Failed to drop %s: %v", name, err)
+		}
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "123"})
+
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.UpdateGameStateLogic(ctx, collection, redisCache, "Mode1", "active"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.LeaveModeLogic(ctx, collection, redisCache, "Mode1", "player1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// A failed delivery leaves the offset where it was
+	sink := &recordingSink{failures: 1}
+	if _, err := logic.RelayOutboxLogic(ctx, collection, sink, 2); err == nil {
+		t.Fatalf("expected the sink error to be returned")
+	}
+	delivered, err := logic.RelayOutboxLogic(ctx, collection, sink, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if delivered != 3 {
+		t.Fatalf("expected 3 events to be delivered, got %d", delivered)
+	}
+	want := []string{logic.OutboxEventPlayerJoined, logic.OutboxEventGameStateChanged, logic.OutboxEventPlayerLeft}
+	for i, event := range sink.events {
+		if event.Type != want[i] || event.ModeName != "Mode1" || event.Seq != int64(i+1) {
+			t.Fatalf("unexpected event %d: %+v", i, event)
+		}
+	}
+	if sink.events[1].Data["status"] != "active" {
+		t.Fatalf("expected the new status in the state change event, got %+v", sink.events[1].Data)
+	}
+
+	// Nothing new means nothing is sent again
+	if delivered, err := logic.RelayOutboxLogic(ctx, collection, sink, 2); err != nil || delivered != 0 {
+		t.Fatalf("expected nothing to relay, got %d, %v", delivered, err)
+	}
+	consumers, err := logic.ListOutboxConsumersLogic(ctx, collection)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(consumers) != 1 || consumers[0].LastSeq != 3 || consumers[0].Pending != 0 {
+		t.Fatalf("unexpected consumers %+v", consumers)
+	}
+}
+
+func TestNDJSONSinkWritesOneEventPerLine(t *testing.T) {
+	var buf bytes.Buffer
+	sink := logic.NewNDJSONSink("buffer", &buf)
+	events := []logic.OutboxEvent{
+		{Type: logic.OutboxEventPlayerJoined, ModeName: "Mode1", PlayerID: "player1"},
+		{Type: logic.OutboxEventPlayerLeft, ModeName: "Mode1", PlayerID: "player1"},
+	}
+	if err := sink.Publish(context.Background(), events); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", buf.String())
+	}
+	var event logic.OutboxEvent
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil || event.Type != logic.OutboxEventPlayerLeft {
+		t.Fatalf("unexpected second line %q: %v", lines[1], err)
+	}
+}
+
+func TestLoadConfigRejectsUnknownOutboxSinks(t *testing.T) {
+	args := []string{"-env-file=/nonexistent/.env", "-mongodb-uri=mongodb://localhost:27017", "-redis-addr=localhost:6379"}
+	defer config.LoadConfig(args)
+
+	if _, err := config.Load(append(args, "-outbox-sinks=stdout,redis-stream:events,webhook:https://example.com/hook")); err != nil {
+		t.Fatalf("expected valid sinks to be accepted, got %v", err)
+	}
+	_, err := config.Load(append(args, "-outbox-sinks=kafka:events,webhook:not-a-url"))
+	if err == nil || !strings.Contains(err.Error(), "kafka:events") || !strings.Contains(err.Error(), "webhook:not-a-url") {
+		t.Fatalf("expected both sinks to be rejected, got %v", err)
+	}
+	if _, err := logic.ParseOutboxSink("kafka:events", nil); err == nil {
+		t.Fatalf("expected ParseOutboxSink to reject an unknown sink")
+	}
+}
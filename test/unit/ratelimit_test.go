@@ -0,0 +1,26 @@
+package unit
+
+import (
+	"testing"
+
+	"multiplayer-webservice/internal/ratelimit"
+)
+
+func TestLimiterEnforcesBurstAndCanBeReconfigured(t *testing.T) {
+	limiter := ratelimit.NewLimiter(1, 2)
+
+	if !limiter.Allow() || !limiter.Allow() {
+		t.Fatalf("expected the first two requests to fit in the burst")
+	}
+	if limiter.Allow() {
+		t.Fatalf("expected the third request to be rejected")
+	}
+
+	// A rate of 0 disables limiting
+	limiter.SetLimit(0, 0)
+	for i := 0; i < 100; i++ {
+		if !limiter.Allow() {
+			t.Fatalf("expected request %d to be allowed once limiting is disabled", i)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestRecommendAreaWeighsLatencyAgainstLoad(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("regions").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop regions: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	for _, key := range []string{"regions", "area_stats:eu-west", "area_stats:eu-north"} {
+		redisCache.Delete(ctx, key)
+	}
+
+	regions := []logic.Region{
+		{AreaCode: "eu", Name: "Europe"},
+		{AreaCode: "eu-west", Name: "Europe West", Parent: "eu", Endpoints: []string{"west.example.com:443"}},
+		{AreaCode: "eu-north", Name: "Europe North", Parent: "eu", Endpoints: []string{"north.example.com:443"}},
+	}
+	for _, region := range regions {
+		if err := logic.PutRegionLogic(ctx, collection, redisCache, region); err != nil {
+			t.Fatalf("expected no error storing %s, got %v", region.AreaCode, err)
+		}
+	}
+	if err := logic.PutRegionLogic(ctx, collection, redisCache, logic.Region{AreaCode: "eu", Name: "Europe", Parent: "eu-west"}); !errors.Is(err, logic.ErrInvalidRegion) {
+		t.Fatalf("expected ErrInvalidRegion for a cycle, got %v", err)
+	}
+	if err := logic.DeleteRegionLogic(ctx, collection, redisCache, "eu"); !errors.Is(err, logic.ErrInvalidRegion) {
+		t.Fatalf("expected ErrInvalidRegion for a region with children, got %v", err)
+	}
+
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "eu-west", ActiveUsers: 400})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "eu-north", ActiveUsers: 10})
+	// Other modes do not count towards the load of Mode1
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode2", AreaCode: "eu-north", ActiveUsers: 1000})
+
+	if _, err := logic.RecommendAreaLogic(ctx, collection, redisCache, "NoSuchMode", "player1", 0.05); !errors.Is(err, logic.ErrModeNotFound) {
+		t.Fatalf("expected ErrModeNotFound, got %v", err)
+	}
+	if _, err := logic.RecommendAreaLogic(ctx, collection, redisCache, "Mode1", "player1", 0.05); !errors.Is(err, logic.ErrNoLatencies) {
+		t.Fatalf("expected ErrNoLatencies, got %v", err)
+	}
+	if err := logic.ReportLatenciesLogic(ctx, collection, redisCache, "player1", map[string]int{"mars": 5}, time.Minute); !errors.Is(err, logic.ErrRegionNotFound) {
+		t.Fatalf("expected ErrRegionNotFound, got %v", err)
+	}
+	if err := logic.ReportLatenciesLogic(ctx, collection, redisCache, "player1", map[string]int{"eu-west": 20, "eu-north": 30}, time.Minute); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// eu-west scores 20 + 400*0.05 = 40, eu-north 30 + 10*0.05 = 30.5
+	candidates, err := logic.RecommendAreaLogic(ctx, collection, redisCache, "Mode1", "player1", 0.05)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(candidates) != 2 || candidates[0].Region.AreaCode != "eu-north" || candidates[0].ActiveUsers != 10 || candidates[1].ActiveUsers != 400 {
+		t.Fatalf("expected eu-north to be recommended, got %+v", candidates)
+	}
+
+	// Without a load penalty the lowest latency wins
+	candidates, err = logic.RecommendAreaLogic(ctx, collection, redisCache, "Mode1", "player1", 0)
+	if err != nil || candidates[0].Region.AreaCode != "eu-west" {
+		t.Fatalf("expected eu-west to be recommended, got %+v (%v)", candidates, err)
+	}
+}
+
+func TestAreaCountsRollUpThroughTheHierarchy(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("regions").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop regions: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	for _, key := range []string{"regions", "area_stats:de", "area_stats:de-south", "area_stats:de-south-munich", "area_stats:fr"} {
+		redisCache.Delete(ctx, key)
+	}
+
+	for _, region := range []logic.Region{
+		{AreaCode: "de", Name: "Germany"},
+		{AreaCode: "de-south", Name: "Southern Germany", Parent: "de"},
+		{AreaCode: "de-south-munich", Name: "Munich", Parent: "de-south"},
+		{AreaCode: "fr", Name: "France"},
+	} {
+		if err := logic.PutRegionLogic(ctx, collection, redisCache, region); err != nil {
+			t.Fatalf("expected no error storing %s, got %v", region.AreaCode, err)
+		}
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "de-south-munich", ActiveUsers: 5, SpectatorCount: 1})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode2", AreaCode: "de-south", ActiveUsers: 3})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode3", AreaCode: "fr", ActiveUsers: 7})
+
+	for area, want := range map[string]int32{"de-south-munich": 5, "de-south": 8, "de": 8, "fr": 7} {
+		got, err := logic.GetActiveUsersByAreaCodeLogic(ctx, collection, redisCache, area)
+		if err != nil {
+			t.Fatalf("expected no error for %s, got %v", area, err)
+		}
+		if got != want {
+			t.Fatalf("expected %d active users in %s, got %d", want, area, got)
+		}
+	}
+
+	stats, err := logic.GetAreaStatsLogic(ctx, collection, redisCache, "de")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stats.Modes != 2 || stats.Spectators != 1 {
+		t.Fatalf("expected 2 modes and 1 spectator in de, got %+v", stats)
+	}
+}
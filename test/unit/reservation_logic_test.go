@@ -0,0 +1,86 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestSeatReservationsHoldSeatsUntilUsedOrExpired(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	args := []string{"-env-file=/nonexistent/.env", "-mongodb-uri=mongodb://localhost:27017", "-redis-addr=localhost:6379"}
+	if err := config.LoadConfig(append(args, "-default-mode-capacity=2")); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	defer config.LoadConfig(args)
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", ActiveUsers: 1, Players: []string{"player1"}})
+
+	reservation, err := logic.ReserveSeatLogic(ctx, collection, redisCache, "Mode1", "player2", time.Minute)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// The reserved seat fills the mode for everyone else
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player3"); !errors.Is(err, logic.ErrModeFull) {
+		t.Fatalf("expected ErrModeFull, got %v", err)
+	}
+	if _, err := logic.ReserveSeatLogic(ctx, collection, redisCache, "Mode1", "player3", time.Minute); !errors.Is(err, logic.ErrModeFull) {
+		t.Fatalf("expected ErrModeFull, got %v", err)
+	}
+
+	if err := logic.JoinModeWithReservationLogic(ctx, collection, redisCache, "Mode1", "player3", reservation.Token); !errors.Is(err, logic.ErrReservationInvalid) {
+		t.Fatalf("expected a token issued to another player to be rejected, got %v", err)
+	}
+	if err := logic.JoinModeWithReservationLogic(ctx, collection, redisCache, "Mode1", "player2", reservation.Token); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.JoinModeWithReservationLogic(ctx, collection, redisCache, "Mode1", "player2", reservation.Token); !errors.Is(err, logic.ErrReservationInvalid) {
+		t.Fatalf("expected a used token to be rejected, got %v", err)
+	}
+
+	// An expired reservation stops counting without waiting for the sweeper
+	logic.LeaveModeLogic(ctx, collection, redisCache, "Mode1", "player2")
+	if _, err := logic.ReserveSeatLogic(ctx, collection, redisCache, "Mode1", "player4", 50*time.Millisecond); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player3"); err != nil {
+		t.Fatalf("expected the expired seat to be free, got %v", err)
+	}
+
+	if err := logic.ReleaseExpiredReservationsLogic(ctx, collection, time.Now()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var mode logic.ModeUsage
+	collection.FindOne(ctx, map[string]string{"mode_name": "Mode1"}).Decode(&mode)
+	if len(mode.Reservations) != 0 {
+		t.Fatalf("expected expired reservations to be removed, got %+v", mode.Reservations)
+	}
+
+	// Renewing a reservation in a full mode swaps it, and joining without the token uses it up
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode2", ActiveUsers: 1, Players: []string{"player1"}})
+	first, err := logic.ReserveSeatLogic(ctx, collection, redisCache, "Mode2", "player2", time.Minute)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	renewed, err := logic.ReserveSeatLogic(ctx, collection, redisCache, "Mode2", "player2", time.Minute)
+	if err != nil || renewed.Token == first.Token {
+		t.Fatalf("expected a new reservation, got %+v, %v", renewed, err)
+	}
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode2", "player2"); err != nil {
+		t.Fatalf("expected the player to take the reserved seat, got %v", err)
+	}
+	collection.FindOne(ctx, map[string]string{"mode_name": "Mode2"}).Decode(&mode)
+	if mode.ActiveUsers != 2 || len(mode.Reservations) != 0 {
+		t.Fatalf("expected 2 players and no reservation left, got %d and %+v", mode.ActiveUsers, mode.Reservations)
+	}
+}
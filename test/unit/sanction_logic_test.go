@@ -0,0 +1,66 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestBansKeepPlayersOutUntilTheyExpire(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("sanctions").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop sanctions: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", ActiveUsers: 2, Players: []string{"player1", "player2"}, SpectatorCount: 1, Spectators: []string{"player2"}})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode2"})
+
+	if err := logic.KickPlayerLogic(ctx, collection, redisCache, "Mode1", "player1", "spamming", "mod"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.KickPlayerLogic(ctx, collection, redisCache, "Mode1", "player1", "again", "mod"); !errors.Is(err, logic.ErrPlayerNotInMode) {
+		t.Fatalf("expected ErrPlayerNotInMode, got %v", err)
+	}
+
+	// A timed ban scoped to Mode1 also removes the player from it, as a player and as a spectator
+	if _, err := logic.BanPlayerLogic(ctx, collection, redisCache, "player2", "Mode1", 50*time.Millisecond, "cheating", "mod"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var mode logic.ModeUsage
+	collection.FindOne(ctx, map[string]string{"mode_name": "Mode1"}).Decode(&mode)
+	if mode.ActiveUsers != 0 || len(mode.Players) != 0 {
+		t.Fatalf("expected Mode1 to be empty, got %d users %v", mode.ActiveUsers, mode.Players)
+	}
+	if mode.SpectatorCount != 0 || len(mode.Spectators) != 0 {
+		t.Fatalf("expected no spectators left in Mode1, got %d %v", mode.SpectatorCount, mode.Spectators)
+	}
+
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player2"); !errors.Is(err, logic.ErrPlayerBanned) {
+		t.Fatalf("expected ErrPlayerBanned, got %v", err)
+	}
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode2", "player2"); err != nil {
+		t.Fatalf("expected the scoped ban not to cover Mode2, got %v", err)
+	}
+
+	active, err := logic.ListSanctionsLogic(ctx, collection, logic.SanctionFilter{PlayerID: "player2"}, time.Now())
+	if err != nil || len(active) != 1 {
+		t.Fatalf("expected one active ban, got %d (%v)", len(active), err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player2"); err != nil {
+		t.Fatalf("expected join after the ban expired to succeed, got %v", err)
+	}
+	all, err := logic.ListSanctionsLogic(ctx, collection, logic.SanctionFilter{IncludeExpired: true}, time.Now())
+	if err != nil || len(all) != 2 {
+		t.Fatalf("expected the kick and the expired ban in the history, got %d (%v)", len(all), err)
+	}
+}
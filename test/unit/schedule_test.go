@@ -0,0 +1,58 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestModeScheduleAvailability(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	endsAt := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	schedule := &logic.ModeSchedule{
+		EndsAt:   &endsAt,
+		TimeZone: "America/New_York",
+		Weekly: []logic.WeeklyWindow{
+			{Weekday: time.Friday, Start: "18:00", End: "02:00"}, // wraps into Saturday
+			{Weekday: time.Sunday, Start: "12:00", End: "14:00"},
+		},
+	}
+	if err := schedule.Validate(); err != nil {
+		t.Fatalf("expected a valid schedule, got %v", err)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"friday evening", time.Date(2024, 3, 8, 19, 0, 0, 0, ny), true},
+		{"after midnight into saturday", time.Date(2024, 3, 9, 1, 30, 0, 0, ny), true},
+		{"saturday morning", time.Date(2024, 3, 9, 2, 0, 0, 0, ny), false},
+		{"sunday window across DST change", time.Date(2024, 3, 10, 13, 0, 0, 0, ny), true},
+		{"sunday evening", time.Date(2024, 3, 10, 15, 0, 0, 0, ny), false},
+		{"friday after the schedule ended", time.Date(2024, 4, 5, 19, 0, 0, 0, ny), false},
+	}
+	for _, tt := range tests {
+		got, err := schedule.AvailableAt(tt.at)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected available=%v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	var unscheduled *logic.ModeSchedule
+	if available, _ := unscheduled.AvailableAt(time.Now()); !available {
+		t.Errorf("expected a mode without a schedule to be available")
+	}
+	invalid := &logic.ModeSchedule{Weekly: []logic.WeeklyWindow{{Weekday: time.Monday, Start: "25:00", End: "26:00"}}}
+	if err := invalid.Validate(); err == nil {
+		t.Errorf("expected an invalid window to be rejected")
+	}
+}
@@ -0,0 +1,94 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestSeasonRecordsAreArchivedAtRollover(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	for _, name := range []string{"seasons", "season_records", "season_standings_archive"} {
+		if err := collection.Database().Collection(name).Drop(ctx); err != nil {
+			t.Fatalf("Failed to drop %s: %v", name, err)
+		}
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s1 := logic.Season{SeasonID: "s1", Modes: []string{"Ranked"}, StartsAt: start, EndsAt: start.Add(30 * 24 * time.Hour)}
+	if err := logic.CreateSeasonLogic(ctx, collection, s1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	overlapping := logic.Season{SeasonID: "s1b", StartsAt: start.Add(24 * time.Hour), EndsAt: start.Add(48 * time.Hour)}
+	if err := logic.CreateSeasonLogic(ctx, collection, overlapping); err != logic.ErrSeasonOverlap {
+		t.Fatalf("expected ErrSeasonOverlap, got %v", err)
+	}
+
+	during := start.Add(24 * time.Hour)
+	matches := [][]logic.MatchResult{
+		{{PlayerID: "alice", Won: true, Points: 25}, {PlayerID: "bob", Won: false, Points: 5}},
+		{{PlayerID: "alice", Won: false, Points: 5}, {PlayerID: "bob", Won: true, Points: 30}},
+	}
+	for _, results := range matches {
+		seasonID, err := logic.RecordMatchOutcomeLogic(ctx, collection, "Ranked", "", results, during)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if seasonID != "s1" {
+			t.Fatalf("expected the current season s1, got %s", seasonID)
+		}
+	}
+
+	_, standings, err := logic.GetSeasonStandingsLogic(ctx, collection, "Ranked", "", 10, during)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(standings) != 2 || standings[0].PlayerID != "bob" || standings[0].Points != 35 || standings[1].Wins != 1 || standings[1].Losses != 1 {
+		t.Fatalf("unexpected standings: %+v", standings)
+	}
+
+	after := s1.EndsAt.Add(time.Minute)
+	if _, err := logic.RecordMatchOutcomeLogic(ctx, collection, "Ranked", "s1", matches[0], after); err != logic.ErrSeasonClosed {
+		t.Fatalf("expected ErrSeasonClosed, got %v", err)
+	}
+	if err := logic.ArchiveEndedSeasonsLogic(ctx, collection, after); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	season, archived, err := logic.GetSeasonStandingsLogic(ctx, collection, "Ranked", "s1", 10, after)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if season.ArchivedAt == nil || len(archived) != 2 || archived[0].PlayerID != "bob" || archived[0].Rank != 1 {
+		t.Fatalf("expected archived standings with bob first, got %+v", archived)
+	}
+	if count, _ := collection.Database().Collection("season_records").CountDocuments(ctx, map[string]string{"season_id": "s1"}); count != 0 {
+		t.Fatalf("expected live records to be reset, found %d", count)
+	}
+	if _, _, err := logic.GetSeasonStandingsLogic(ctx, collection, "Ranked", "", 10, after); err != logic.ErrNoActiveSeason {
+		t.Fatalf("expected ErrNoActiveSeason after rollover, got %v", err)
+	}
+
+	// A result that was accepted just before the season was marked archived is archived by the
+	// next run and ranked with the rest
+	late := logic.SeasonRecord{SeasonID: "s1", ModeName: "Ranked", PlayerID: "carol", Wins: 3, Points: 90}
+	if _, err := collection.Database().Collection("season_records").InsertOne(ctx, late); err != nil {
+		t.Fatalf("Failed to insert late record: %v", err)
+	}
+	if err := logic.ArchiveEndedSeasonsLogic(ctx, collection, after.Add(time.Minute)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, archived, err = logic.GetSeasonStandingsLogic(ctx, collection, "Ranked", "s1", 10, after)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(archived) != 3 || archived[0].PlayerID != "carol" || archived[1].PlayerID != "bob" || archived[1].Rank != 2 {
+		t.Fatalf("expected carol to be archived ahead of bob, got %+v", archived)
+	}
+	if count, _ := collection.Database().Collection("season_records").CountDocuments(ctx, map[string]string{"season_id": "s1"}); count != 0 {
+		t.Fatalf("expected the late record to be removed, found %d", count)
+	}
+}
@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/logic"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSessionStatsSummariseJoinsAndLeaves(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	if err := collection.Database().Collection("sessions").Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop sessions: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "123"})
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode2", AreaCode: "456"})
+
+	from := time.Now()
+	join := func(mode, player string) {
+		if err := logic.JoinModeLogic(ctx, collection, redisCache, mode, player); err != nil {
+			t.Fatalf("expected no error joining %s, got %v", mode, err)
+		}
+	}
+	leave := func(mode, player string) {
+		if err := logic.LeaveModeLogic(ctx, collection, redisCache, mode, player); err != nil {
+			t.Fatalf("expected no error leaving %s, got %v", mode, err)
+		}
+	}
+	// Mode1 sees sessions of about 100ms, 200ms and 400ms, Mode2 one of about 100ms
+	for _, player := range []string{"player1", "player2", "player3"} {
+		join("Mode1", player)
+	}
+	join("Mode2", "player4")
+	time.Sleep(100 * time.Millisecond)
+	leave("Mode1", "player1")
+	leave("Mode2", "player4")
+	time.Sleep(100 * time.Millisecond)
+	leave("Mode1", "player2")
+	time.Sleep(200 * time.Millisecond)
+	leave("Mode1", "player3")
+	// Still open, so not counted
+	join("Mode1", "player5")
+	to := time.Now().Add(time.Second)
+
+	byMode, err := logic.GetSessionStatsLogic(ctx, collection, logic.SessionGroupByMode, "", from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(byMode) != 2 || byMode[0].Key != "Mode1" || byMode[1].Key != "Mode2" {
+		t.Fatalf("expected stats for Mode1 and Mode2, got %+v", byMode)
+	}
+	mode1 := byMode[0]
+	if mode1.SessionCount != 3 {
+		t.Fatalf("expected 3 closed sessions in Mode1, got %d", mode1.SessionCount)
+	}
+	if mode1.MedianSeconds < 0.2 || mode1.MedianSeconds > 0.3 {
+		t.Fatalf("expected a median of about 0.2s, got %f", mode1.MedianSeconds)
+	}
+	if mode1.AverageSeconds < 0.23 || mode1.AverageSeconds > 0.33 {
+		t.Fatalf("expected an average of about 0.23s, got %f", mode1.AverageSeconds)
+	}
+
+	byArea, err := logic.GetSessionStatsLogic(ctx, collection, logic.SessionGroupByArea, "456", from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(byArea) != 1 || byArea[0].Key != "456" || byArea[0].SessionCount != 1 || byArea[0].AverageSeconds != byArea[0].MedianSeconds {
+		t.Fatalf("expected the single Mode2 session for area 456, got %+v", byArea)
+	}
+
+	var session logic.PlayerSession
+	if err := collection.Database().Collection("sessions").FindOne(ctx, bson.M{"player_id": "player1"}).Decode(&session); err != nil {
+		t.Fatalf("expected the session of player1, got %v", err)
+	}
+	if session.EndReason != logic.SessionEndLeft || session.LeftAt == nil || session.AreaCode != "123" {
+		t.Fatalf("expected a session closed by leaving in area 123, got %+v", session)
+	}
+}
+
+func TestEvictStaleSessionsClosesSessionsOfAbsentPlayers(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	sessions := collection.Database().Collection("sessions")
+	if err := sessions.Drop(ctx); err != nil {
+		t.Fatalf("Failed to drop sessions: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", AreaCode: "123"})
+	for _, player := range []string{"player1", "player2"} {
+		if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", player); err != nil {
+			t.Fatalf("expected no error joining, got %v", err)
+		}
+	}
+	// player1 disappears from the mode without its session being closed
+	if _, err := collection.UpdateOne(ctx, bson.M{"mode_name": "Mode1"}, bson.M{"$pull": bson.M{"players": "player1"}}); err != nil {
+		t.Fatalf("Failed to remove player1: %v", err)
+	}
+
+	evicted, err := logic.EvictStaleSessionsLogic(ctx, collection, time.Now())
+	if err != nil || evicted != 1 {
+		t.Fatalf("expected 1 evicted session, got %d, %v", evicted, err)
+	}
+	var session logic.PlayerSession
+	if err := sessions.FindOne(ctx, bson.M{"player_id": "player1"}).Decode(&session); err != nil {
+		t.Fatalf("expected the session of player1, got %v", err)
+	}
+	if session.EndReason != logic.SessionEndEvicted || session.LeftAt == nil {
+		t.Fatalf("expected the session of player1 to be evicted, got %+v", session)
+	}
+	if err := sessions.FindOne(ctx, bson.M{"player_id": "player2"}).Decode(&session); err != nil || session.LeftAt != nil {
+		t.Fatalf("expected the session of player2 to stay open, got %+v (%v)", session, err)
+	}
+
+	// Nothing is left to evict
+	if evicted, err := logic.EvictStaleSessionsLogic(ctx, collection, time.Now()); err != nil || evicted != 0 {
+		t.Fatalf("expected nothing to evict, got %d, %v", evicted, err)
+	}
+}
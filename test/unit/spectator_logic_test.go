@@ -0,0 +1,54 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+)
+
+func TestSpectatorsAreCountedSeparatelyAndLimited(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	args := []string{"-env-file=/nonexistent/.env", "-mongodb-uri=mongodb://localhost:27017", "-redis-addr=localhost:6379"}
+	if err := config.LoadConfig(append(args, "-default-spectator-capacity=2")); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	defer config.LoadConfig(args)
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1", ActiveUsers: 1, Players: []string{"player1"}})
+
+	for _, spectator := range []string{"viewer1", "viewer2", "viewer1"} {
+		if err := logic.JoinAsSpectatorLogic(ctx, collection, redisCache, "Mode1", spectator); err != nil {
+			t.Fatalf("expected %s to join, got %v", spectator, err)
+		}
+	}
+	if err := logic.JoinAsSpectatorLogic(ctx, collection, redisCache, "Mode1", "viewer3"); !errors.Is(err, logic.ErrSpectatorsFull) {
+		t.Fatalf("expected ErrSpectatorsFull, got %v", err)
+	}
+
+	details, err := logic.GetModeDetailsLogic(ctx, collection, redisCache, "Mode1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if details.ActiveUsers != 1 || details.Spectators != 2 {
+		t.Fatalf("expected 1 player and 2 spectators, got %d and %d", details.ActiveUsers, details.Spectators)
+	}
+
+	if err := logic.LeaveSpectatorLogic(ctx, collection, redisCache, "Mode1", "viewer1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	stats, err := logic.GetGameModeStatsLogic(ctx, collection, redisCache)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stats.TotalSpectators != 1 || stats.TotalActiveUsers != 1 {
+		t.Fatalf("expected 1 spectator and 1 active user, got %d and %d", stats.TotalSpectators, stats.TotalActiveUsers)
+	}
+}
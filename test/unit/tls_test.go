@@ -0,0 +1,165 @@
+package unit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/auth"
+	"multiplayer-webservice/internal/tlsconfig"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func issueCert(t *testing.T, commonName string, serial int64, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signerCert, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signerCert, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCert{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func writeKeyPair(t *testing.T, c *testCert, certPath, keyPath string) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	if err := os.WriteFile(certPath, c.pem, 0o600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+}
+
+func TestMutualTLSIdentityAuthorizationAndReload(t *testing.T) {
+	dir := t.TempDir()
+	ca := issueCert(t, "test-ca", 1, nil)
+	caPath := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(caPath, ca.pem, 0o600); err != nil {
+		t.Fatalf("failed to write CA: %v", err)
+	}
+	certPath, keyPath := filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem")
+	writeKeyPair(t, issueCert(t, "localhost", 2, ca), certPath, keyPath)
+
+	reloader, err := tlsconfig.NewReloader(certPath, keyPath, caPath, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(auth.GinMiddleware())
+	router.GET("/admin", auth.NewAuthorizer([]string{"ops-bot"}).RequireAdminHTTP(), func(c *gin.Context) {
+		c.String(http.StatusOK, auth.Identity(c.Request.Context()))
+	})
+	server := httptest.NewUnstartedServer(router)
+	server.TLS = reloader.ServerConfig()
+	server.StartTLS()
+	defer server.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.cert)
+	clientFor := func(commonName string) *http.Client {
+		client := issueCert(t, commonName, 10, ca)
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs: rootCAs,
+			Certificates: []tls.Certificate{{
+				Certificate: [][]byte{client.cert.Raw},
+				PrivateKey:  client.key,
+			}},
+		}}}
+	}
+
+	resp, err := clientFor("ops-bot").Get(server.URL + "/admin")
+	if err != nil {
+		t.Fatalf("expected admin request to succeed, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200 for admin identity, got %d", resp.StatusCode)
+	}
+
+	resp, err = clientFor("random-player").Get(server.URL + "/admin")
+	if err != nil {
+		t.Fatalf("expected request to complete, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected status 403 for non-admin identity, got %d", resp.StatusCode)
+	}
+
+	anonymous := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: rootCAs}}}
+	if resp, err := anonymous.Get(server.URL + "/admin"); err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected handshake without a client certificate to fail")
+	}
+
+	// Rotate the server certificate on disk and wait for the reloader to pick it up
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.Watch(ctx, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond) // make sure the new files get a different modification time
+	writeKeyPair(t, issueCert(t, "localhost", 3, ca), certPath, keyPath)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		client := clientFor("ops-bot")
+		client.Transport.(*http.Transport).DisableKeepAlives = true
+		resp, err := client.Get(server.URL + "/admin")
+		if err != nil {
+			t.Fatalf("expected request to succeed after rotation, got %v", err)
+		}
+		resp.Body.Close()
+		if resp.TLS.PeerCertificates[0].SerialNumber.Int64() == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected rotated certificate to be served")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,64 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func TestUniquePlayersFallBackToMemoryWithoutRedis(t *testing.T) {
+	// Nothing listens on port 1, so every Redis command fails fast
+	unreachable := &cache.RedisCache{Client: redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})}
+	defer unreachable.Client.Close()
+
+	ctx := context.Background()
+	key := "unique_players:test:" + time.Now().Format(time.RFC3339Nano)
+	unreachable.AddUnique(ctx, key, time.Hour, "player1")
+	unreachable.AddUnique(ctx, key, time.Hour, "player2")
+	unreachable.AddUnique(ctx, key, time.Hour, "player1")
+
+	if count := unreachable.CountUnique(ctx, key); count != 2 {
+		t.Fatalf("expected 2 unique players from the in-memory fallback, got %d", count)
+	}
+}
+
+func TestUniquePlayersReplayedIntoHyperLogLogKeepTheirTTL(t *testing.T) {
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	unreachable := &cache.RedisCache{Client: redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})}
+	defer unreachable.Client.Close()
+
+	ctx := context.Background()
+	key := "unique_players:test:" + time.Now().Format(time.RFC3339Nano)
+	defer redisCache.Delete(ctx, key)
+	// Held in memory while Redis is down, then replayed into the HyperLogLog by the next count
+	unreachable.AddUnique(ctx, key, time.Hour, "player1", "player2")
+	if count := redisCache.CountUnique(ctx, key); count != 2 {
+		t.Fatalf("expected 2 unique players in the HyperLogLog, got %d", count)
+	}
+	if ttl := redisCache.Client.TTL(ctx, key).Val(); ttl <= 0 || ttl > time.Hour {
+		t.Fatalf("expected the replayed key to expire within an hour, got TTL %v", ttl)
+	}
+
+	redisCache.AddUnique(ctx, key, 2*time.Hour, "player2", "player3")
+	if count := redisCache.CountUnique(ctx, key); count != 3 {
+		t.Fatalf("expected 3 unique players in the HyperLogLog, got %d", count)
+	}
+	if ttl := redisCache.Client.TTL(ctx, key).Val(); ttl <= time.Hour || ttl > 2*time.Hour {
+		t.Fatalf("expected AddUnique to refresh the TTL to two hours, got %v", ttl)
+	}
+}
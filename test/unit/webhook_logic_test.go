@@ -0,0 +1,185 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"multiplayer-webservice/internal/cache"
+	"multiplayer-webservice/internal/config"
+	"multiplayer-webservice/internal/logic"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestWebhookRetryPolicyBacksOffExponentially(t *testing.T) {
+	policy := logic.WebhookRetryPolicy{MaxAttempts: 10, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+	want := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}
+	for i, delay := range want {
+		if got := policy.Backoff(i + 1); got != delay {
+			t.Fatalf("expected %s after %d failures, got %s", delay, i+1, got)
+		}
+	}
+}
+
+func TestWebhooksAreSignedRetriedAndDeadLettered(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	for _, name := range []string{"webhooks", "webhook_deliveries"} {
+		if err := collection.Database().Collection(name).Drop(ctx); err != nil {
+			t.Fatalf("Failed to drop %s: %v", name, err)
+		}
+	}
+	if err := logic.EnsureIndexes(ctx, collection); err != nil {
+		t.Fatalf("Failed to create indexes: %v", err)
+	}
+
+	var mu sync.Mutex
+	secrets := map[string]string{}
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		calls[r.URL.Path]++
+		want := logic.SignWebhookPayload(secrets[r.URL.Path], r.Header.Get(logic.WebhookTimestampHeader), body)
+		if r.Header.Get(logic.WebhookSignatureHeader) != want {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// /flaky fails its first call, /down never recovers
+		if r.URL.Path == "/down" || calls[r.URL.Path] == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if _, err := logic.CreateWebhookLogic(ctx, collection, logic.Webhook{URL: server.URL, Events: []string{"bogus"}}); !errors.Is(err, logic.ErrInvalidWebhook) {
+		t.Fatalf("expected ErrInvalidWebhook, got %v", err)
+	}
+	flaky, err := logic.CreateWebhookLogic(ctx, collection, logic.Webhook{URL: server.URL + "/flaky", ModeName: "Mode1", Events: []string{logic.WebhookEventModeStarted}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	down, err := logic.CreateWebhookLogic(ctx, collection, logic.Webhook{URL: server.URL + "/down", Secret: "s3cret"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if flaky.Secret == "" || down.Secret != "s3cret" {
+		t.Fatalf("expected a generated secret and the given one, got %q and %q", flaky.Secret, down.Secret)
+	}
+	secrets["/flaky"], secrets["/down"] = flaky.Secret, down.Secret
+
+	events := []logic.OutboxEvent{
+		{ID: primitive.NewObjectID(), Type: logic.OutboxEventGameStateChanged, ModeName: "Mode1", Data: bson.M{"status": "active", "previous_status": "closed"}, At: time.Now()},
+		{ID: primitive.NewObjectID(), Type: logic.OutboxEventPlayerJoined, ModeName: "Mode2", PlayerID: "player1", Data: bson.M{"active_users": 2.0, "capacity": 2.0}, At: time.Now()},
+	}
+	dispatcher := logic.NewWebhookDispatcher(collection)
+	// The relay delivers at least once; dispatching the same events again adds nothing
+	for i := 0; i < 2; i++ {
+		if err := dispatcher.Publish(ctx, events); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	queued, err := logic.ListWebhookDeliveriesLogic(ctx, collection, down.WebhookID, "", 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(queued) != 4 {
+		t.Fatalf("expected state change, mode_started, join and mode_full deliveries, got %d", len(queued))
+	}
+
+	policy := logic.WebhookRetryPolicy{MaxAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	now := time.Now()
+	if delivered, err := logic.DeliverWebhooksLogic(ctx, collection, server.Client(), policy, 10, now); err != nil || delivered != 0 {
+		t.Fatalf("expected every first attempt to fail, got %d, %v", delivered, err)
+	}
+	if delivered, err := logic.DeliverWebhooksLogic(ctx, collection, server.Client(), policy, 10, now); err != nil || delivered != 0 {
+		t.Fatalf("expected nothing to be due before the backoff, got %d, %v", delivered, err)
+	}
+	if delivered, err := logic.DeliverWebhooksLogic(ctx, collection, server.Client(), policy, 10, now.Add(2*time.Minute)); err != nil || delivered != 1 {
+		t.Fatalf("expected the flaky webhook to succeed on retry, got %d, %v", delivered, err)
+	}
+
+	flakyLog, err := logic.ListWebhookDeliveriesLogic(ctx, collection, flaky.WebhookID, "", 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(flakyLog) != 1 || flakyLog[0].Status != logic.WebhookDeliveryDelivered || len(flakyLog[0].AttemptLog) != 2 || flakyLog[0].AttemptLog[0].StatusCode != http.StatusInternalServerError {
+		t.Fatalf("unexpected delivery log %+v", flakyLog)
+	}
+	dead, err := logic.ListWebhookDeliveriesLogic(ctx, collection, "", logic.WebhookDeliveryDead, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(dead) != 4 {
+		t.Fatalf("expected the down webhook's deliveries in the dead-letter list, got %d", len(dead))
+	}
+
+	retried, err := logic.RetryWebhookDeliveryLogic(ctx, collection, dead[0].ID.Hex(), time.Now())
+	if err != nil || retried.Status != logic.WebhookDeliveryPending || retried.Attempts != 0 {
+		t.Fatalf("expected the delivery to be queued again, got %+v, %v", retried, err)
+	}
+	if _, err := logic.RetryWebhookDeliveryLogic(ctx, collection, flakyLog[0].ID.Hex(), time.Now()); !errors.Is(err, logic.ErrWebhookDeliveryNotDead) {
+		t.Fatalf("expected ErrWebhookDeliveryNotDead, got %v", err)
+	}
+}
+
+func TestModeFullCountsReservedSeats(t *testing.T) {
+	collection := setupTestDB(t)
+	ctx := context.Background()
+	for _, name := range []string{"outbox", "outbox_offsets", "webhooks", "webhook_deliveries"} {
+		if err := collection.Database().Collection(name).Drop(ctx); err != nil {
+			t.Fatalf("Failed to drop %s: %v", name, err)
+		}
+	}
+	if err := logic.EnsureIndexes(ctx, collection); err != nil {
+		t.Fatalf("Failed to create indexes: %v", err)
+	}
+	redisCache, err := cache.InitializeCache("localhost:6379", "", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize Redis cache: %v", err)
+	}
+	args := []string{"-env-file=/nonexistent/.env", "-mongodb-uri=mongodb://localhost:27017", "-redis-addr=localhost:6379"}
+	if err := config.LoadConfig(append(args, "-default-mode-capacity=2")); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	defer config.LoadConfig(args)
+	collection.InsertOne(ctx, logic.ModeUsage{ModeName: "Mode1"})
+
+	webhook, err := logic.CreateWebhookLogic(ctx, collection, logic.Webhook{URL: "http://127.0.0.1:1/hook", ModeName: "Mode1", Events: []string{logic.WebhookEventModeFull}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// player1 takes the last free seat while player2 holds the other one, then player2 takes their own
+	reservation, err := logic.ReserveSeatLogic(ctx, collection, redisCache, "Mode1", "player2", time.Minute)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.JoinModeLogic(ctx, collection, redisCache, "Mode1", "player1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := logic.JoinModeWithReservationLogic(ctx, collection, redisCache, "Mode1", "player2", reservation.Token); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := logic.RelayOutboxLogic(ctx, collection, logic.NewWebhookDispatcher(collection), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	deliveries, err := logic.ListWebhookDeliveriesLogic(ctx, collection, webhook.WebhookID, "", 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].EventType != logic.WebhookEventModeFull {
+		t.Fatalf("expected a single mode_full delivery for player1's join, got %+v", deliveries)
+	}
+}